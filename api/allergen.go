@@ -26,6 +26,16 @@ func (s *Server) AllergenCreate(c echo.Context) error {
 	return c.JSON(http.StatusCreated, allergen)
 }
 
+func (s *Server) AllergenConsistency(c echo.Context) error {
+	report, err := s.db.AllergenConsistency()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to check Allergen consistency")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, report)
+}
+
 func (s *Server) AllergenDetails(c echo.Context) error {
 	allergenId, err := strconv.ParseUint(c.Param("id"), 10, 64)
 	if err != nil {
@@ -38,6 +48,7 @@ func (s *Server) AllergenDetails(c echo.Context) error {
 		log.Error().Err(err).Uint64("id", allergenId).Msg("Failed to read Allergen")
 		return err
 	}
+	allergen = s.db.TranslateAllergens(s.requestLanguage(c), []models.Allergen{allergen})[0]
 
 	return c.JSON(http.StatusOK, allergen)
 }
@@ -72,6 +83,7 @@ func (s *Server) AllergenDishes(c echo.Context) error {
 		log.Error().Err(err).Uint64("id", allergenId).Msg("Failed to read Allergen Dishes")
 		return err
 	}
+	dishes = s.db.TranslateDishes(s.requestLanguage(c), dishes)
 
 	return c.JSON(http.StatusOK, models.PaginationDishes{Limit: limit, Page: page, Dishes: dishes})
 }
@@ -82,6 +94,7 @@ func (s *Server) AllergenList(c echo.Context) error {
 		log.Error().Err(err).Msg("Failed to list Allergen")
 		return err
 	}
+	categories = s.db.TranslateAllergens(s.requestLanguage(c), categories)
 
 	return c.JSON(http.StatusOK, categories)
 }
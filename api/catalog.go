@@ -0,0 +1,181 @@
+package api
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"tfm_backend/models"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+// CSV catalogs have a row per dish - lists are separated by | and promotions are start/end/cost.
+// The category tree and the allergens of the ingredients are only in JSON catalogs.
+var catalogCsvHeader = []string{"name", "description", "cost", "categories", "ingredients", "allergens", "promotions"}
+
+func (s *Server) CatalogExport(c echo.Context) error {
+	catalog, err := s.db.CatalogExport()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to export catalog")
+		return err
+	}
+
+	filename := fmt.Sprintf(`catalog-%s`, time.Now().Format("20060102"))
+	if c.QueryParam("format") == "csv" {
+		err = catalogCsvCheck(catalog)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
+		c.Response().Header().Set(echo.HeaderContentType, "text/csv; charset=UTF-8")
+		c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s.csv"`, filename))
+		c.Response().WriteHeader(http.StatusOK)
+		return catalogWriteCsv(c.Response(), catalog)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s.json"`, filename))
+	return c.JSON(http.StatusOK, catalog)
+}
+
+func (s *Server) CatalogImport(c echo.Context) error {
+	var err error
+	var catalog models.Catalog
+
+	dryRun, _ := strconv.ParseBool(c.QueryParam("dryRun"))
+
+	if c.QueryParam("format") == "csv" {
+		catalog, err = catalogReadCsv(c.Request().Body)
+	} else {
+		err = json.NewDecoder(c.Request().Body).Decode(&catalog)
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to read catalog")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	changes, err := s.db.CatalogImport(catalog, dryRun, authenticatedUserId(c))
+	if err != nil {
+		log.Error().Err(err).Bool("dryRun", dryRun).Msg("Failed to import catalog")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, models.CatalogImport{DryRun: dryRun, Changes: changes})
+}
+
+func catalogReadCsv(r io.Reader) (models.Catalog, error) {
+	var catalog models.Catalog
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = len(catalogCsvHeader)
+	records, err := reader.ReadAll()
+	if err != nil {
+		return catalog, err
+	}
+
+	for i, record := range records {
+		if i == 0 && strings.EqualFold(record[0], catalogCsvHeader[0]) {
+			continue
+		}
+
+		dish := models.CatalogDish{
+			Name:        strings.TrimSpace(record[0]),
+			Description: record[1],
+			Categories:  catalogSplit(record[3]),
+			Ingredients: catalogSplit(record[4]),
+			Allergens:   catalogSplit(record[5]),
+		}
+		dish.Cost, err = models.ParseMoney(record[2])
+		if err != nil {
+			return catalog, fmt.Errorf("line %d: invalid cost: %w", i+1, err)
+		}
+
+		for _, value := range catalogSplit(record[6]) {
+			parts := strings.Split(value, "/")
+			if len(parts) != 3 {
+				return catalog, fmt.Errorf("line %d: promotions must be start/end/cost", i+1)
+			}
+			var promotion models.CatalogPromotion
+			promotion.StartTime, err = time.Parse(time.RFC3339, parts[0])
+			if err == nil {
+				promotion.EndTime, err = time.Parse(time.RFC3339, parts[1])
+			}
+			if err == nil {
+				promotion.Cost, err = models.ParseMoney(parts[2])
+			}
+			if err != nil {
+				return catalog, fmt.Errorf("line %d: invalid promotion: %w", i+1, err)
+			}
+			dish.Promotions = append(dish.Promotions, promotion)
+		}
+
+		catalog.Dishes = append(catalog.Dishes, dish)
+	}
+
+	return catalog, nil
+}
+
+// CSV promotions only have start/end/cost - the other ones need the JSON catalog, they aren't dropped
+func catalogCsvCheck(catalog models.Catalog) error {
+	for _, category := range catalog.Categories {
+		if len(category.Promotions) > 0 {
+			return fmt.Errorf("category %s has promotions that don't fit in CSV, export the catalog as JSON", category.Name)
+		}
+	}
+	for _, dish := range catalog.Dishes {
+		for _, promotion := range dish.Promotions {
+			if (len(promotion.Type) > 0 && promotion.Type != models.PromotionPrice) || len(promotion.DailyStart) > 0 ||
+				len(promotion.DailyEnd) > 0 || len(promotion.Weekdays) > 0 {
+				return fmt.Errorf("dish %s has a %s promotion that doesn't fit in CSV, export the catalog as JSON", dish.Name, promotion.Type)
+			}
+		}
+	}
+	return nil
+}
+
+func catalogSplit(value string) []string {
+	var values []string
+	for _, part := range strings.Split(value, "|") {
+		if part = strings.TrimSpace(part); len(part) > 0 {
+			values = append(values, part)
+		}
+	}
+	return values
+}
+
+func catalogWriteCsv(w io.Writer, catalog models.Catalog) error {
+	writer := csv.NewWriter(w)
+
+	err := writer.Write(catalogCsvHeader)
+	if err != nil {
+		return err
+	}
+
+	for _, dish := range catalog.Dishes {
+		var promotions []string
+		for _, promotion := range dish.Promotions {
+			promotions = append(promotions, fmt.Sprintf(`%s/%s/%s`,
+				promotion.StartTime.Format(time.RFC3339), promotion.EndTime.Format(time.RFC3339), promotion.Cost))
+		}
+
+		err = writer.Write([]string{
+			dish.Name,
+			dish.Description,
+			dish.Cost.String(),
+			strings.Join(dish.Categories, "|"),
+			strings.Join(dish.Ingredients, "|"),
+			strings.Join(dish.Allergens, "|"),
+			strings.Join(promotions, "|"),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
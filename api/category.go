@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"strconv"
 	"tfm_backend/models"
+	"tfm_backend/orm"
 
 	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog/log"
@@ -38,6 +39,7 @@ func (s *Server) CategoryDetails(c echo.Context) error {
 		log.Error().Err(err).Uint64("id", categoryId).Msg("Failed to read Category")
 		return err
 	}
+	category = s.db.TranslateCategories(s.requestLanguage(c), []models.Category{category})[0]
 
 	return c.JSON(http.StatusOK, category)
 }
@@ -66,12 +68,14 @@ func (s *Server) CategoryDishes(c echo.Context) error {
 	}
 
 	limit, page, offset := parsePagination(c)
+	descendants, _ := strconv.ParseBool(c.QueryParam("descendants"))
 
-	dishes, err := s.db.CategoryDishes(categoryId, limit, offset)
+	dishes, err := s.db.CategoryDishes(categoryId, descendants, limit, offset)
 	if err != nil {
 		log.Error().Err(err).Uint64("id", categoryId).Msg("Failed to read Category Dishes")
 		return err
 	}
+	dishes = s.db.TranslateDishes(s.requestLanguage(c), dishes)
 
 	return c.JSON(http.StatusOK, models.PaginationDishes{Limit: limit, Page: page, Dishes: dishes})
 }
@@ -82,6 +86,13 @@ func (s *Server) CategoryList(c echo.Context) error {
 		log.Error().Err(err).Msg("Failed to list Category")
 		return err
 	}
+	categories = s.db.TranslateCategories(s.requestLanguage(c), categories)
+
+	// tree by default, ?flat=true keeps the plain list
+	flat, _ := strconv.ParseBool(c.QueryParam("flat"))
+	if !flat {
+		categories = orm.CategoryTree(categories)
+	}
 
 	return c.JSON(http.StatusOK, categories)
 }
@@ -109,3 +120,20 @@ func (s *Server) CategoryModify(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, category)
 }
+
+func (s *Server) CategoryReorder(c echo.Context) error {
+	var order models.CategoryOrder
+	err := c.Bind(&order)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to bind Category order")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	err = s.db.CategoryReorder(order)
+	if err != nil {
+		log.Error().Err(err).Interface("order", order).Msg("Failed to reorder Category")
+		return err
+	}
+
+	return s.CategoryList(c)
+}
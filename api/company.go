@@ -0,0 +1,154 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"tfm_backend/models"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+func (s *Server) CompanyCreate(c echo.Context) error {
+	var company models.Company
+	err := c.Bind(&company)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to bind company")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	company, err = s.db.CompanyCreate(company)
+	if err != nil {
+		log.Error().Err(err).Interface("company", company).Msg("Failed to create company")
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, company)
+}
+
+func (s *Server) CompanyDelete(c echo.Context) error {
+	companyId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	err = s.db.CompanyDelete(companyId)
+	if err != nil {
+		log.Error().Err(err).Uint64("id", companyId).Msg("Failed to delete company")
+		return err
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+func (s *Server) CompanyDetails(c echo.Context) error {
+	companyId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	company, err := s.db.CompanyDetails(companyId)
+	if err != nil {
+		log.Error().Err(err).Uint64("id", companyId).Msg("Failed to read company")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, company)
+}
+
+func (s *Server) CompanyList(c echo.Context) error {
+	limit, page, offset := parsePagination(c)
+
+	companies, err := s.db.CompanyList(limit, offset)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list companies")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, models.PaginationCompanies{Limit: limit, Page: page, Companies: companies})
+}
+
+func (s *Server) CompanyModify(c echo.Context) error {
+	companyId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var company models.Company
+	err = c.Bind(&company)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to bind company")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	company.ID = companyId
+
+	company, err = s.db.CompanyModify(company)
+	if err != nil {
+		log.Error().Err(err).Interface("company", company).Msg("Failed to modify company")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, company)
+}
+
+// Payroll statement of all the employees (?month=2006-01, current month by default)
+func (s *Server) CompanyStatement(c echo.Context) error {
+	companyId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	month, err := parseMonth(c)
+	if err != nil {
+		return err
+	}
+
+	statement, err := s.db.CompanyStatement(companyId, month)
+	if err != nil {
+		log.Error().Err(err).Uint64("id", companyId).Time("month", month).Msg("Failed to get company statement")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, statement)
+}
+
+// Posts the payroll deduction of the month (?month=2006-01) to the wallets of the employees
+func (s *Server) CompanySettle(c echo.Context) error {
+	companyId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	month, err := parseMonth(c)
+	if err != nil {
+		return err
+	}
+
+	statement, err := s.db.CompanySettle(authenticatedUserId(c), companyId, month)
+	if err != nil {
+		log.Error().Err(err).Uint64("id", companyId).Time("month", month).Msg("Failed to settle company payroll")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, statement)
+}
+
+func parseMonth(c echo.Context) (time.Time, error) {
+	if len(c.QueryParam("month")) == 0 {
+		return time.Now(), nil
+	}
+
+	month, err := time.ParseInLocation("2006-01", c.QueryParam("month"), time.Now().Location())
+	if err != nil {
+		log.Error().Err(err).Str("month", c.QueryParam("month")).Msg("Failed to parse month")
+		return month, echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return month, nil
+}
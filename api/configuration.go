@@ -2,7 +2,6 @@ package api
 
 import (
 	"net/http"
-	"tfm_backend/models"
 
 	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog/log"
@@ -19,8 +18,14 @@ func (s *Server) ConfigurationDetails(c echo.Context) error {
 }
 
 func (s *Server) ConfigurationModify(c echo.Context) error {
-	var config models.Configuration
-	err := c.Bind(&config)
+	// the request changes only the settings it sends - the rest keep their current values
+	config, err := s.db.ConfigurationDetails()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to read configuracion")
+		return err
+	}
+
+	err = c.Bind(&config)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to bind configuracion")
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
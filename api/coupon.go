@@ -0,0 +1,140 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"tfm_backend/models"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+func (s *Server) CouponCreate(c echo.Context) error {
+	var coupon models.Coupon
+	err := c.Bind(&coupon)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to bind coupon")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	coupon, err = s.db.CouponCreate(coupon)
+	if err != nil {
+		log.Error().Err(err).Interface("coupon", coupon).Msg("Failed to create coupon")
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, coupon)
+}
+
+func (s *Server) CouponDelete(c echo.Context) error {
+	couponId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	err = s.db.CouponDelete(couponId)
+	if err != nil {
+		log.Error().Err(err).Uint64("id", couponId).Msg("Failed to delete coupon")
+		return err
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+func (s *Server) CouponDetails(c echo.Context) error {
+	couponId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	coupon, err := s.db.CouponDetails(couponId)
+	if err != nil {
+		log.Error().Err(err).Uint64("id", couponId).Msg("Failed to read coupon")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, coupon)
+}
+
+func (s *Server) CouponList(c echo.Context) error {
+	limit, page, offset := parsePagination(c)
+
+	coupons, err := s.db.CouponList(limit, offset)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list coupons")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, models.PaginationCoupons{Limit: limit, Page: page, Coupons: coupons})
+}
+
+func (s *Server) CouponModify(c echo.Context) error {
+	couponId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var coupon models.Coupon
+	err = c.Bind(&coupon)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to bind coupon")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	coupon.ID = couponId
+
+	coupon, err = s.db.CouponModify(coupon)
+	if err != nil {
+		log.Error().Err(err).Interface("coupon", coupon).Msg("Failed to modify coupon")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, coupon)
+}
+
+func (s *Server) OrderCouponApply(c echo.Context) error {
+	orderId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var apply models.CouponApply
+	err = c.Bind(&apply)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to bind coupon code")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var userId = authenticatedUserId(c)
+
+	// Only the owner of the Order can apply coupons
+	order, err := s.db.OrderCouponApply(userId, orderId, apply.Code)
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", orderId).Str("code", apply.Code).Msg("Failed to apply coupon")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, order)
+}
+
+func (s *Server) OrderCouponRemove(c echo.Context) error {
+	orderId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var userId = authenticatedUserId(c)
+
+	// Only the owner of the Order can remove coupons
+	order, err := s.db.OrderCouponRemove(userId, orderId)
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", orderId).Msg("Failed to remove coupon")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, order)
+}
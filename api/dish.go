@@ -3,12 +3,17 @@ package api
 import (
 	"net/http"
 	"strconv"
+	"strings"
 	"tfm_backend/models"
 
 	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog/log"
 )
 
+func (s *Server) DishArchive(c echo.Context) error {
+	return s.dishArchive(c, true)
+}
+
 func (s *Server) DishCount(c echo.Context) error {
 	count, err := s.db.DishCount()
 	if err != nil {
@@ -27,7 +32,7 @@ func (s *Server) DishCreate(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
 
-	dish, err = s.db.DishCreate(dish)
+	dish, err = s.db.DishCreate(dish, authenticatedUserId(c))
 	if err != nil {
 		log.Error().Err(err).Interface("dish", dish).Msg("Failed to create dish")
 		return err
@@ -52,6 +57,10 @@ func (s *Server) DishDelete(c echo.Context) error {
 	return c.NoContent(http.StatusOK)
 }
 
+func (s *Server) DishUnarchive(c echo.Context) error {
+	return s.dishArchive(c, false)
+}
+
 func (s *Server) DishDetails(c echo.Context) error {
 	dishId, err := strconv.ParseUint(c.Param("id"), 10, 64)
 	if err != nil {
@@ -64,6 +73,7 @@ func (s *Server) DishDetails(c echo.Context) error {
 		log.Error().Err(err).Uint64("id", dishId).Msg("Failed to read dish")
 		return err
 	}
+	dish = s.db.TranslateDishes(s.requestLanguage(c), []models.Dish{dish})[0]
 
 	return c.JSON(http.StatusOK, dish)
 }
@@ -97,6 +107,7 @@ func (s *Server) DishFavourites(c echo.Context) error {
 		log.Error().Err(err).Int64("userId", userId).Msg("Failed to list favourite dishes")
 		return err
 	}
+	dishes = s.db.TranslateDishes(s.requestLanguage(c), dishes)
 
 	return c.JSON(http.StatusOK, models.PaginationDishes{Limit: limit, Page: page, Dishes: dishes})
 }
@@ -117,16 +128,63 @@ func (s *Server) DishLike(c echo.Context) error {
 	return c.JSON(http.StatusOK, true)
 }
 
+func (s *Server) DishRecommendations(c echo.Context) error {
+	var userId = authenticatedUserId(c)
+
+	limit, page, offset := parsePagination(c)
+
+	dishes, err := s.db.DishRecommendations(userId, limit, offset)
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", userId).Msg("Failed to list recommended dishes")
+		return err
+	}
+	dishes = s.db.TranslateDishes(s.requestLanguage(c), dishes)
+
+	return c.JSON(http.StatusOK, models.PaginationDishes{Limit: limit, Page: page, Dishes: dishes})
+}
+
+func (s *Server) DishSuggestions(c echo.Context) error {
+	var dishIds []uint64
+	for _, value := range strings.Split(c.QueryParam("dishes"), ",") {
+		if len(value) == 0 {
+			continue
+		}
+		dishId, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Str("dishes", c.QueryParam("dishes")).Msg(msgErrorIdToInt)
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		dishIds = append(dishIds, dishId)
+	}
+
+	limit, page, _ := parsePagination(c)
+
+	dishes, err := s.db.DishSuggestions(dishIds, limit)
+	if err != nil {
+		log.Error().Err(err).Interface("dishIds", dishIds).Msg("Failed to list suggested dishes")
+		return err
+	}
+	dishes = s.db.TranslateDishes(s.requestLanguage(c), dishes)
+
+	return c.JSON(http.StatusOK, models.PaginationDishes{Limit: limit, Page: page, Dishes: dishes})
+}
+
 func (s *Server) DishList(c echo.Context) error {
 	limit, page, offset := parsePagination(c)
 
 	searchTerm := c.QueryParam("searchTerm")
+	language := s.requestLanguage(c)
+
+	// Only administrators can see archived dishes
+	includeArchived, _ := strconv.ParseBool(c.QueryParam("archived"))
+	includeArchived = includeArchived && authenticated(c) && authenticatedIsAdministrator(c)
 
-	dishes, err := s.db.DishList(searchTerm, limit, offset)
+	dishes, err := s.db.DishList(searchTerm, language, includeArchived, limit, offset)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to list dishes")
 		return err
 	}
+	dishes = s.db.TranslateDishes(language, dishes)
 
 	return c.JSON(http.StatusOK, models.PaginationDishes{Limit: limit, Page: page, Dishes: dishes})
 }
@@ -146,7 +204,7 @@ func (s *Server) DishModify(c echo.Context) error {
 	}
 	dish.ID = dishId
 
-	dish, err = s.db.DishModify(dish)
+	dish, err = s.db.DishModify(dish, authenticatedUserId(c))
 	if err != nil {
 		log.Error().Err(err).Interface("dish", dish).Msg("Failed to modify dish")
 		return err
@@ -154,3 +212,43 @@ func (s *Server) DishModify(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, dish)
 }
+
+// Portions left to order - null removes the limit
+func (s *Server) DishStock(c echo.Context) error {
+	dishId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var request models.DishStockRequest
+	err = c.Bind(&request)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to bind dish stock")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	dish, err := s.db.DishStock(dishId, request.Stock)
+	if err != nil {
+		log.Error().Err(err).Uint64("id", dishId).Msg("Failed to change dish stock")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dish)
+}
+
+func (s *Server) dishArchive(c echo.Context, archived bool) error {
+	dishId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	dish, err := s.db.DishArchive(dishId, archived)
+	if err != nil {
+		log.Error().Err(err).Uint64("id", dishId).Bool("archived", archived).Msg("Failed to archive dish")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dish)
+}
@@ -0,0 +1,74 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"tfm_backend/models"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+func (s *Server) DishPriceDelete(c echo.Context) error {
+	dishId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	priceId, err := strconv.ParseUint(c.Param("priceid"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("priceid", c.Param("priceid")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	err = s.db.DishPriceDelete(dishId, priceId)
+	if err != nil {
+		log.Error().Err(err).Uint64("dishId", dishId).Uint64("priceId", priceId).Msg("Failed to delete dish price")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+func (s *Server) DishPriceList(c echo.Context) error {
+	dishId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	prices, err := s.db.DishPriceList(dishId)
+	if err != nil {
+		log.Error().Err(err).Uint64("dishId", dishId).Msg("Failed to list dish prices")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, prices)
+}
+
+func (s *Server) DishPriceSchedule(c echo.Context) error {
+	dishId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var price models.DishPrice
+	err = c.Bind(&price)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to bind dish price")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	price.ID = 0
+	price.DishID = dishId
+	price.UserID = authenticatedUserId(c)
+
+	price, err = s.db.DishPriceSchedule(price)
+	if err != nil {
+		log.Error().Err(err).Interface("price", price).Msg("Failed to schedule dish price")
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, price)
+}
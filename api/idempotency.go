@@ -0,0 +1,98 @@
+package api
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+const headerIdempotencyKey = "Idempotency-Key"
+
+// Copies the response body while it is written to the client
+type responseRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+// Answers retries of a request with the same Idempotency-Key header with the response of the first one,
+// so unreliable connections don't create the same order twice. Needs requiresLogin before it.
+func (s *Server) idempotent(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		key := c.Request().Header.Get(headerIdempotencyKey)
+		if len(key) == 0 {
+			return next(c)
+		}
+		if len(key) > 250 {
+			return echo.NewHTTPError(http.StatusBadRequest, "Idempotency-Key is too long")
+		}
+
+		body, err := io.ReadAll(c.Request().Body)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to read request body")
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		c.Request().Body = io.NopCloser(bytes.NewReader(body))
+
+		hash := sha256.New()
+		hash.Write([]byte(c.Request().Method + " " + c.Request().URL.RequestURI() + "\n"))
+		hash.Write(body)
+		requestHash := fmt.Sprintf(`%x`, hash.Sum(nil))
+
+		userId := authenticatedUserId(c)
+		record, started, err := s.db.IdempotencyKeyStart(userId, key, requestHash)
+		if err != nil {
+			return err
+		}
+
+		if !started {
+			if record.RequestHash != requestHash {
+				return echo.NewHTTPError(http.StatusUnprocessableEntity, "Idempotency-Key was already used with a different request")
+			}
+			if record.StatusCode == 0 {
+				return echo.NewHTTPError(http.StatusConflict, "a request with this Idempotency-Key is still running")
+			}
+
+			log.Debug().Uint64("userId", userId).Str("key", key).Msg("Replaying idempotent response")
+			if len(record.Response) == 0 {
+				return c.NoContent(record.StatusCode)
+			}
+			return c.JSONBlob(record.StatusCode, []byte(record.Response))
+		}
+
+		// a panic in the handler doesn't finish the request - the key is freed, so it can be retried
+		finished := false
+		defer func() {
+			if !finished {
+				s.db.IdempotencyKeyRelease(record.ID)
+			}
+		}()
+
+		recorder := &responseRecorder{ResponseWriter: c.Response().Writer}
+		c.Response().Writer = recorder
+
+		// render errors here, so their response is recorded too
+		err = next(c)
+		if err != nil {
+			c.Error(err)
+		}
+
+		// unexpected errors can be retried
+		if c.Response().Status >= http.StatusInternalServerError {
+			return nil
+		}
+
+		finished = true
+		s.db.IdempotencyKeyFinish(record.ID, c.Response().Status, recorder.body.String())
+		return nil
+	}
+}
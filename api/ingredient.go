@@ -38,6 +38,7 @@ func (s *Server) IngredientDetails(c echo.Context) error {
 		log.Error().Err(err).Uint64("id", ingredientId).Msg("Failed to read Ingredient")
 		return err
 	}
+	ingredient = s.db.TranslateIngredients(s.requestLanguage(c), []models.Ingredient{ingredient})[0]
 
 	return c.JSON(http.StatusOK, ingredient)
 }
@@ -72,6 +73,7 @@ func (s *Server) IngredientDishes(c echo.Context) error {
 		log.Error().Err(err).Uint64("id", ingredientId).Msg("Failed to read Ingredient Dishes")
 		return err
 	}
+	dishes = s.db.TranslateDishes(s.requestLanguage(c), dishes)
 
 	return c.JSON(http.StatusOK, models.PaginationDishes{Limit: limit, Page: page, Dishes: dishes})
 }
@@ -82,6 +84,7 @@ func (s *Server) IngredientList(c echo.Context) error {
 		log.Error().Err(err).Msg("Failed to list Ingredient")
 		return err
 	}
+	categories = s.db.TranslateIngredients(s.requestLanguage(c), categories)
 
 	return c.JSON(http.StatusOK, categories)
 }
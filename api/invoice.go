@@ -0,0 +1,144 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"tfm_backend/documents"
+	"tfm_backend/models"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+// PDF receipt of a delivered order - issued the first time, the same document afterwards
+func (s *Server) OrderReceipt(c echo.Context) error {
+	orderId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var userId int64 = int64(authenticatedUserId(c))
+	if authenticatedIsAdministrator(c) {
+		userId = -1
+	}
+
+	invoice, err := s.db.InvoiceReceipt(userId, orderId)
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", orderId).Msg("Failed to issue receipt")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return invoicePDF(c, invoice)
+}
+
+// PDF invoice of the month (?month=2006-01) for the user, or another user for administrators (?user=)
+func (s *Server) InvoiceMonthly(c echo.Context) error {
+	userId, err := walletUserId(c)
+	if err != nil {
+		return err
+	}
+
+	month, err := parseMonth(c)
+	if err != nil {
+		return err
+	}
+
+	invoice, err := s.db.InvoiceMonthlyUser(userId, month)
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", userId).Time("month", month).Msg("Failed to issue monthly invoice")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return invoicePDF(c, invoice)
+}
+
+// PDF invoice of the month (?month=2006-01) for the company
+func (s *Server) CompanyInvoice(c echo.Context) error {
+	companyId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	month, err := parseMonth(c)
+	if err != nil {
+		return err
+	}
+
+	invoice, err := s.db.InvoiceMonthlyCompany(companyId, month)
+	if err != nil {
+		log.Error().Err(err).Uint64("id", companyId).Time("month", month).Msg("Failed to issue company invoice")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return invoicePDF(c, invoice)
+}
+
+// PDF of a document already issued
+func (s *Server) InvoiceDetails(c echo.Context) error {
+	invoiceId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var userId int64 = int64(authenticatedUserId(c))
+	if authenticatedIsAdministrator(c) {
+		userId = -1
+	}
+
+	invoice, err := s.db.InvoiceDetails(userId, invoiceId)
+	if err != nil {
+		log.Error().Err(err).Uint64("id", invoiceId).Msg("Failed to read invoice")
+		return err
+	}
+
+	return invoicePDF(c, invoice)
+}
+
+// Receipts and invoices of the user (?user= for administrators), or of a company (?company=, administrators only)
+func (s *Server) InvoiceList(c echo.Context) error {
+	var companyId uint64
+	var err error
+	if len(c.QueryParam("company")) > 0 {
+		if !authenticatedIsAdministrator(c) {
+			return echo.NewHTTPError(http.StatusForbidden, `Only an Administrator can see the invoices of a company`)
+		}
+		companyId, err = strconv.ParseUint(c.QueryParam("company"), 10, 64)
+		if err != nil {
+			log.Error().Err(err).Str("company", c.QueryParam("company")).Msg(msgErrorIdToInt)
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+	}
+
+	userId, err := walletUserId(c)
+	if err != nil {
+		return err
+	}
+
+	limit, page, offset := parsePagination(c)
+
+	invoices, err := s.db.InvoiceList(userId, companyId, limit, offset)
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", userId).Uint64("companyId", companyId).Msg("Failed to list invoices")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, models.PaginationInvoices{Limit: limit, Page: page, Invoices: invoices})
+}
+
+// Renders the content stored when the document was issued
+func invoicePDF(c echo.Context, invoice models.Invoice) error {
+	var content models.InvoiceContent
+	err := json.Unmarshal([]byte(invoice.Content), &content)
+	if err != nil {
+		log.Error().Err(err).Uint64("id", invoice.ID).Msg("Failed to decode invoice content")
+		return err
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s.pdf"`, invoice.Number))
+	return c.Blob(http.StatusOK, "application/pdf", documents.InvoicePDF(content))
+}
@@ -0,0 +1,87 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"tfm_backend/models"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+func (s *Server) ModifierGroupCreate(c echo.Context) error {
+	dishId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var group models.ModifierGroup
+	err = c.Bind(&group)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to bind modifier group")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	group.DishID = dishId
+
+	group, err = s.db.ModifierGroupCreate(group)
+	if err != nil {
+		log.Error().Err(err).Interface("group", group).Msg("Failed to create modifier group")
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, group)
+}
+
+func (s *Server) ModifierGroupDelete(c echo.Context) error {
+	dishId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	groupId, err := strconv.ParseUint(c.Param("groupid"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("groupid", c.Param("groupid")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	err = s.db.ModifierGroupDelete(dishId, groupId)
+	if err != nil {
+		log.Error().Err(err).Uint64("dishId", dishId).Uint64("groupId", groupId).Msg("Failed to delete modifier group")
+		return err
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+func (s *Server) ModifierGroupModify(c echo.Context) error {
+	dishId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	groupId, err := strconv.ParseUint(c.Param("groupid"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("groupid", c.Param("groupid")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var group models.ModifierGroup
+	err = c.Bind(&group)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to bind modifier group")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	group.ID = groupId
+	group.DishID = dishId
+
+	group, err = s.db.ModifierGroupModify(group)
+	if err != nil {
+		log.Error().Err(err).Interface("group", group).Msg("Failed to modify modifier group")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, group)
+}
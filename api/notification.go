@@ -0,0 +1,39 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"tfm_backend/models"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+func (s *Server) NotificationList(c echo.Context) error {
+	limit, page, offset := parsePagination(c)
+	unreadOnly := c.QueryParam("unread") == "true"
+
+	notifications, err := s.db.NotificationList(authenticatedUserId(c), unreadOnly, limit, offset)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list notifications")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, models.PaginationNotifications{Limit: limit, Page: page, Notifications: notifications})
+}
+
+func (s *Server) NotificationRead(c echo.Context) error {
+	notificationId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	err = s.db.NotificationRead(authenticatedUserId(c), notificationId)
+	if err != nil {
+		log.Error().Err(err).Uint64("id", notificationId).Msg("Failed to mark notification as read")
+		return err
+	}
+
+	return c.NoContent(http.StatusOK)
+}
@@ -3,6 +3,7 @@ package api
 import (
 	"net/http"
 	"strconv"
+	"strings"
 	"tfm_backend/models"
 	"time"
 
@@ -31,6 +32,7 @@ func (s *Server) OrderCount(c echo.Context) error {
 	return c.JSON(http.StatusOK, counts)
 }
 
+// Orders are cancelled, not deleted - they are kept in the history (?reason= for administrators)
 func (s *Server) OrderDelete(c echo.Context) error {
 	orderId, err := strconv.ParseUint(c.Param("id"), 10, 64)
 	if err != nil {
@@ -38,12 +40,9 @@ func (s *Server) OrderDelete(c echo.Context) error {
 		return c.NoContent(http.StatusBadRequest)
 	}
 
-	var userId = authenticatedUserId(c)
-
-	// Only the owner of the Order can delete it
-	err = s.db.OrderDelete(userId, orderId)
+	_, err = s.db.OrderCancel(authenticatedUserId(c), authenticatedIsAdministrator(c), orderId, c.QueryParam("reason"), "")
 	if err != nil {
-		log.Error().Err(err).Uint64("id", orderId).Msg("Failed to delete order")
+		log.Error().Err(err).Uint64("id", orderId).Msg("Failed to cancel order")
 		return err
 	}
 
@@ -91,6 +90,21 @@ func (s *Server) OrderDetails(c echo.Context) error {
 	return c.JSON(http.StatusOK, order)
 }
 
+func (s *Server) OrderKitchen(c echo.Context) error {
+	var dayFilter string = c.QueryParam("day")
+	if len(dayFilter) == 0 {
+		dayFilter = time.Now().Format("2006-01-02")
+	}
+
+	dishes, err := s.db.OrderKitchen(dayFilter)
+	if err != nil {
+		log.Error().Err(err).Str("day", dayFilter).Msg("Failed to read kitchen dishes")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, dishes)
+}
+
 func (s *Server) OrderList(c echo.Context) error {
 	var userId int64 = int64(authenticatedUserId(c))
 	if authenticatedIsAdministrator(c) {
@@ -98,10 +112,17 @@ func (s *Server) OrderList(c echo.Context) error {
 	}
 
 	var dayFilter string = c.QueryParam("day")
+	var deliveryFilter string = c.QueryParam("delivery")
+
+	// ?status=placed,confirmed
+	var statusFilter []string
+	if len(c.QueryParam("status")) > 0 {
+		statusFilter = strings.Split(c.QueryParam("status"), ",")
+	}
 
 	limit, page, offset := parsePagination(c)
 
-	orders, err := s.db.OrderList(userId, dayFilter, limit, offset)
+	orders, err := s.db.OrderList(userId, dayFilter, deliveryFilter, statusFilter, limit, offset)
 	if err != nil {
 		log.Error().Err(err).Int64("userId", userId).Str("day", dayFilter).Msg("Failed to list orders")
 		return err
@@ -213,14 +234,113 @@ func (s *Server) OrderModifiable(c echo.Context) error {
 	return c.JSON(http.StatusOK, map[string]interface{}{"modifiable": modifiable})
 }
 
+func (s *Server) OrderQuote(c echo.Context) error {
+	var order models.Order
+	err := c.Bind(&order)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to bind order")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	order.UserID = authenticatedUserId(c)
+
+	quote, err := s.db.OrderQuote(order)
+	if err != nil {
+		log.Error().Err(err).Interface("order", order).Msg("Failed to quote order")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, quote)
+}
+
+func (s *Server) OrderStatusChange(c echo.Context) error {
+	orderId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var request models.OrderStatusRequest
+	err = c.Bind(&request)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to bind order status")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	// Administrators move orders through the kitchen, customers can only cancel their own orders
+	order, err := s.db.OrderStatusChange(authenticatedUserId(c), authenticatedIsAdministrator(c), orderId, request.Status, request.Comment)
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", orderId).Str("status", request.Status).Msg("Failed to change order status")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, order)
+}
+
+// Administrators can cancel any order not delivered yet, customers their own before the kitchen cutoff
+func (s *Server) OrderCancel(c echo.Context) error {
+	orderId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var request models.OrderCancelRequest
+	err = c.Bind(&request)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to bind order cancellation")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	order, err := s.db.OrderCancel(authenticatedUserId(c), authenticatedIsAdministrator(c), orderId, request.Reason, request.Comment)
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", orderId).Str("reason", request.Reason).Msg("Failed to cancel order")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, order)
+}
+
+func (s *Server) OrderStatusHistory(c echo.Context) error {
+	orderId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var userId int64 = int64(authenticatedUserId(c))
+	if authenticatedIsAdministrator(c) {
+		userId = -1
+	}
+
+	history, err := s.db.OrderStatusHistory(userId, orderId)
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", orderId).Msg("Failed to read order status history")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, history)
+}
+
 func (s *Server) OrderSubvention(c echo.Context) error {
 	var userId = authenticatedUserId(c)
 
-	subvention, err := s.db.OrderSubvention(userId)
+	// subvention for the delivery day (?day=2006-01-02, today by default)
+	delivery := time.Now()
+	if len(c.QueryParam("day")) > 0 {
+		var err error
+		delivery, err = time.ParseInLocation("2006-01-02", c.QueryParam("day"), time.Now().Location())
+		if err != nil {
+			log.Error().Err(err).Str("day", c.QueryParam("day")).Msg("Failed to parse subvention day")
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+	}
+
+	subvention, rule, err := s.db.OrderSubvention(userId, delivery)
 	if err != nil {
-		log.Error().Err(err).Uint64("userId", userId).Msg("Failed to get today's subvention")
+		log.Error().Err(err).Uint64("userId", userId).Time("delivery", delivery).Msg("Failed to get subvention")
 		return err
 	}
 
-	return c.JSON(http.StatusOK, map[string]interface{}{"subvention": subvention})
+	// percentage rules pay part of the order, up to the subvention
+	return c.JSON(http.StatusOK, map[string]interface{}{"subvention": subvention, "rule": rule.Name, "type": rule.Type, "percentage": rule.Percentage})
 }
@@ -0,0 +1,51 @@
+package api
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"tfm_backend/payments"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+const headerPaymentSignature = "Payment-Signature"
+
+func (s *Server) OrderCheckout(c echo.Context) error {
+	orderId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	order, err := s.db.OrderCheckout(authenticatedUserId(c), orderId)
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", orderId).Msg("Failed to checkout order")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, order)
+}
+
+// Notifications of the payment provider - no login, they are signed
+func (s *Server) PaymentWebhook(c echo.Context) error {
+	payload, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to read payment webhook")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	err = s.db.PaymentWebhook(payload, c.Request().Header.Get(headerPaymentSignature))
+	if errors.Is(err, payments.ErrInvalidSignature) {
+		log.Warn().Str("ip", c.RealIP()).Msg("Payment webhook with invalid signature")
+		return echo.ErrUnauthorized
+	}
+	if err != nil {
+		log.Error().Err(err).Bytes("payload", payload).Msg("Failed to process payment webhook")
+		return err
+	}
+
+	return c.NoContent(http.StatusOK)
+}
@@ -0,0 +1,107 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"tfm_backend/models"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+func (s *Server) ReviewApprove(c echo.Context) error {
+	return s.reviewModerate(c, false)
+}
+
+func (s *Server) ReviewDelete(c echo.Context) error {
+	dishId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	// Only the author can delete the review
+	err = s.db.ReviewDelete(authenticatedUserId(c), dishId)
+	if err != nil {
+		log.Error().Err(err).Uint64("dishId", dishId).Msg("Failed to delete review")
+		return err
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+func (s *Server) ReviewHide(c echo.Context) error {
+	return s.reviewModerate(c, true)
+}
+
+func (s *Server) ReviewList(c echo.Context) error {
+	dishId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	limit, page, offset := parsePagination(c)
+
+	reviews, err := s.db.ReviewList(dishId, limit, offset)
+	if err != nil {
+		log.Error().Err(err).Uint64("dishId", dishId).Msg("Failed to list reviews")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, models.PaginationReviews{Limit: limit, Page: page, Reviews: reviews})
+}
+
+func (s *Server) ReviewModerationList(c echo.Context) error {
+	limit, page, offset := parsePagination(c)
+
+	reviews, err := s.db.ReviewModerationList(limit, offset)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list reviews pending moderation")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, models.PaginationReviews{Limit: limit, Page: page, Reviews: reviews})
+}
+
+func (s *Server) ReviewSave(c echo.Context) error {
+	dishId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var review models.DishReview
+	err = c.Bind(&review)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to bind review")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	review.ID = 0
+	review.DishID = dishId
+	review.UserID = authenticatedUserId(c)
+
+	review, err = s.db.ReviewSave(review)
+	if err != nil {
+		log.Error().Err(err).Interface("review", review).Msg("Failed to save review")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, review)
+}
+
+func (s *Server) reviewModerate(c echo.Context, hidden bool) error {
+	reviewId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	review, err := s.db.ReviewModerate(reviewId, hidden)
+	if err != nil {
+		log.Error().Err(err).Uint64("id", reviewId).Bool("hidden", hidden).Msg("Failed to moderate review")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, review)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/rs/zerolog/log"
 	"github.com/ziflex/lecho/v3"
+	"gorm.io/gorm"
 )
 
 type Server struct {
@@ -60,7 +61,15 @@ func NewServer(cfg models.ConfigServer, db *orm.Database) *Server {
 func customHTTPErrorHandler(err error, c echo.Context) {
 	uuid := uuid.NewString()
 	log.Error().Err(err).Str("uuid", uuid).Msg("Reflection")
-	if he, ok := err.(*echo.HTTPError); ok {
+	var conflict *orm.ConflictError
+	var validation *orm.ValidationError
+	if errors.As(err, &conflict) {
+		c.JSON(http.StatusConflict, map[string]interface{}{"message": conflict.Message, "conflicts": conflict.Conflicts, "reflection": uuid})
+	} else if errors.As(err, &validation) {
+		c.JSON(http.StatusBadRequest, map[string]interface{}{"message": validation.Message, "reflection": uuid})
+	} else if errors.Is(err, gorm.ErrRecordNotFound) {
+		c.JSON(http.StatusNotFound, map[string]interface{}{"message": err.Error(), "reflection": uuid})
+	} else if he, ok := err.(*echo.HTTPError); ok {
 		c.JSON(he.Code, map[string]interface{}{"message": he.Message, "reflection": uuid})
 	} else {
 		c.JSON(http.StatusInternalServerError, map[string]interface{}{"message": err.Error(), "reflection": uuid})
@@ -87,6 +96,25 @@ func (s *Server) Listen() error {
 	s.e.GET("/users", s.UserList, s.requiresLogin, requiresAdministrator)
 	s.e.GET("/users/count", s.UserCount, s.requiresLogin, requiresAdministrator)
 
+	// Companies API - their employees pay with the meal wallet
+	gCompanies := s.e.Group("/company")
+	gCompanies.GET("/:id", s.CompanyDetails, s.requiresLogin, requiresAdministrator)
+	gCompanies.POST("/", s.CompanyCreate, s.requiresLogin, requiresAdministrator)
+	gCompanies.PATCH("/:id", s.CompanyModify, s.requiresLogin, requiresAdministrator)
+	gCompanies.DELETE("/:id", s.CompanyDelete, s.requiresLogin, requiresAdministrator)
+	gCompanies.GET("/:id/statement", s.CompanyStatement, s.requiresLogin, requiresAdministrator)
+	gCompanies.POST("/:id/settle", s.CompanySettle, s.requiresLogin, requiresAdministrator, s.idempotent)
+	gCompanies.GET("/:id/invoice", s.CompanyInvoice, s.requiresLogin, requiresAdministrator)
+	s.e.GET("/companies", s.CompanyList, s.requiresLogin, requiresAdministrator)
+
+	// Wallet API
+	gWallet := s.e.Group("/wallet")
+	gWallet.GET("/", s.WalletDetails, s.requiresLogin)
+	gWallet.GET("/transactions", s.WalletTransactionList, s.requiresLogin)
+	gWallet.GET("/statement", s.WalletStatement, s.requiresLogin)
+	gWallet.PUT("/:id/limit", s.WalletLimit, s.requiresLogin, requiresAdministrator)
+	gWallet.POST("/:id/transaction", s.WalletTransactionCreate, s.requiresLogin, requiresAdministrator, s.idempotent)
+
 	// Allergens API
 	gAllergen := s.e.Group("/allergen")
 	gAllergen.POST("/", s.AllergenCreate, s.requiresLogin, requiresAdministrator)
@@ -95,6 +123,7 @@ func (s *Server) Listen() error {
 	gAllergen.DELETE("/:id", s.AllergenDelete, s.requiresLogin, requiresAdministrator)
 	gAllergen.GET("/:id/dishes", s.AllergenDishes)
 	s.e.GET("/allergens", s.AllergenList)
+	s.e.GET("/allergens/consistency", s.AllergenConsistency, s.requiresLogin, requiresAdministrator)
 
 	// Categories API
 	gCategory := s.e.Group("/category")
@@ -104,6 +133,7 @@ func (s *Server) Listen() error {
 	gCategory.DELETE("/:id", s.CategoryDelete, s.requiresLogin, requiresAdministrator)
 	gCategory.GET("/:id/dishes", s.CategoryDishes)
 	s.e.GET("/categories", s.CategoryList)
+	s.e.POST("/categories/reorder", s.CategoryReorder, s.requiresLogin, requiresAdministrator)
 
 	// Ingredients API
 	gIngredient := s.e.Group("/ingredient")
@@ -114,19 +144,44 @@ func (s *Server) Listen() error {
 	gIngredient.GET("/:id/dishes", s.IngredientDishes)
 	s.e.GET("/ingredients", s.IngredientList)
 
+	// Catalog API
+	gCatalog := s.e.Group("/catalog")
+	gCatalog.GET("/export", s.CatalogExport, s.requiresLogin, requiresAdministrator)
+	gCatalog.POST("/import", s.CatalogImport, s.requiresLogin, requiresAdministrator)
+
+	// Translations API
+	gTranslations := s.e.Group("/translation")
+	gTranslations.POST("/", s.TranslationSave, s.requiresLogin, requiresAdministrator)
+	gTranslations.DELETE("/:id", s.TranslationDelete, s.requiresLogin, requiresAdministrator)
+	s.e.GET("/translations", s.TranslationList, s.requiresLogin, requiresAdministrator)
+
 	// Dishes API
 	gDishes := s.e.Group("/dish")
 	// /favourites is authenticated (show list of favourite dishes for user) and unauthenticated (show list of favourite dishes for everybody)
 	gDishes.GET("/favourites", s.DishFavourites, s.optionalLogin)
+	gDishes.GET("/recommendations", s.DishRecommendations, s.requiresLogin)
+	gDishes.GET("/suggestions", s.DishSuggestions)
 	// /:id is authenticated (show like/dislike for user) and authenticated (don't show like/dislike)
 	gDishes.GET("/:id", s.DishDetails, s.optionalLogin)
 	gDishes.POST("/", s.DishCreate, s.requiresLogin, requiresAdministrator)
 	gDishes.PATCH("/:id", s.DishModify, s.requiresLogin, requiresAdministrator)
 	gDishes.DELETE("/:id", s.DishDelete, s.requiresLogin, requiresAdministrator)
+	gDishes.POST("/:id/archive", s.DishArchive, s.requiresLogin, requiresAdministrator)
+	gDishes.POST("/:id/unarchive", s.DishUnarchive, s.requiresLogin, requiresAdministrator)
+	gDishes.PUT("/:id/stock", s.DishStock, s.requiresLogin, requiresAdministrator)
 	s.e.GET("/dishes", s.DishList, s.optionalLogin)
 	s.e.GET("/dishes/count", s.DishCount, s.requiresLogin, requiresAdministrator)
 	gDishes.POST("/:id/like", s.DishLike, s.requiresLogin)
 	gDishes.POST("/:id/dislike", s.DishDislike, s.requiresLogin)
+	gDishes.GET("/:id/reviews", s.ReviewList)
+	gDishes.POST("/:id/review", s.ReviewSave, s.requiresLogin)
+	gDishes.DELETE("/:id/review", s.ReviewDelete, s.requiresLogin)
+	gDishes.GET("/:id/prices", s.DishPriceList, s.requiresLogin, requiresAdministrator)
+	gDishes.POST("/:id/prices", s.DishPriceSchedule, s.requiresLogin, requiresAdministrator)
+	gDishes.DELETE("/:id/prices/:priceid", s.DishPriceDelete, s.requiresLogin, requiresAdministrator)
+	gDishes.POST("/:id/modifiers/", s.ModifierGroupCreate, s.requiresLogin, requiresAdministrator)
+	gDishes.PATCH("/:id/modifiers/:groupid", s.ModifierGroupModify, s.requiresLogin, requiresAdministrator)
+	gDishes.DELETE("/:id/modifiers/:groupid", s.ModifierGroupDelete, s.requiresLogin, requiresAdministrator)
 
 	// Promotions API
 	gPromotions := s.e.Group("/promotion")
@@ -137,18 +192,82 @@ func (s *Server) Listen() error {
 	s.e.GET("/promotions", s.PromotionList)
 	s.e.GET("/promotions/count", s.PromotionCount, s.requiresLogin, requiresAdministrator)
 
-	// Orders API
+	// Reviews API
+	gReviews := s.e.Group("/review")
+	gReviews.POST("/:id/approve", s.ReviewApprove, s.requiresLogin, requiresAdministrator)
+	gReviews.POST("/:id/hide", s.ReviewHide, s.requiresLogin, requiresAdministrator)
+	s.e.GET("/reviews/moderation", s.ReviewModerationList, s.requiresLogin, requiresAdministrator)
+
+	// Set Menus API
+	gSetMenus := s.e.Group("/setmenu")
+	gSetMenus.GET("/:id", s.SetMenuDetails)
+	gSetMenus.POST("/", s.SetMenuCreate, s.requiresLogin, requiresAdministrator)
+	gSetMenus.PATCH("/:id", s.SetMenuModify, s.requiresLogin, requiresAdministrator)
+	gSetMenus.DELETE("/:id", s.SetMenuDelete, s.requiresLogin, requiresAdministrator)
+	s.e.GET("/setmenus", s.SetMenuList)
+
+	// Coupons API
+	gCoupons := s.e.Group("/coupon")
+	gCoupons.GET("/:id", s.CouponDetails, s.requiresLogin, requiresAdministrator)
+	gCoupons.POST("/", s.CouponCreate, s.requiresLogin, requiresAdministrator)
+	gCoupons.PATCH("/:id", s.CouponModify, s.requiresLogin, requiresAdministrator)
+	gCoupons.DELETE("/:id", s.CouponDelete, s.requiresLogin, requiresAdministrator)
+	s.e.GET("/coupons", s.CouponList, s.requiresLogin, requiresAdministrator)
+
+	// Subvention Rules API
+	gSubventionRules := s.e.Group("/subventionrule")
+	gSubventionRules.GET("/:id", s.SubventionRuleDetails, s.requiresLogin, requiresAdministrator)
+	gSubventionRules.POST("/", s.SubventionRuleCreate, s.requiresLogin, requiresAdministrator)
+	gSubventionRules.PATCH("/:id", s.SubventionRuleModify, s.requiresLogin, requiresAdministrator)
+	gSubventionRules.DELETE("/:id", s.SubventionRuleDelete, s.requiresLogin, requiresAdministrator)
+	s.e.GET("/subventionrules", s.SubventionRuleList, s.requiresLogin, requiresAdministrator)
+
+	// Orders API - mutating endpoints accept an Idempotency-Key header
 	gOrders := s.e.Group("/order")
 	gOrders.GET("/subvention", s.OrderSubvention, s.requiresLogin)
-	gOrders.POST("/", s.OrderCreate, s.requiresLogin)
+	gOrders.POST("/", s.OrderCreate, s.requiresLogin, s.idempotent)
+	gOrders.POST("/quote", s.OrderQuote, s.requiresLogin)
 	gOrders.GET("/:id", s.OrderDetails, s.requiresLogin)
 	gOrders.GET("/:id/modifiable", s.OrderModifiable, s.requiresLogin)
-	gOrders.DELETE("/:id", s.OrderDelete, s.requiresLogin)
-	gOrders.POST("/:id/line/", s.OrderLineCreate, s.requiresLogin)
-	gOrders.PATCH("/:id/line/:lineid", s.OrderLineModify, s.requiresLogin)
-	gOrders.DELETE("/:id/line/:lineid", s.OrderLineDelete, s.requiresLogin)
+	gOrders.POST("/:id/status", s.OrderStatusChange, s.requiresLogin, s.idempotent)
+	gOrders.POST("/:id/cancel", s.OrderCancel, s.requiresLogin, s.idempotent)
+	gOrders.GET("/:id/history", s.OrderStatusHistory, s.requiresLogin)
+	gOrders.GET("/:id/receipt", s.OrderReceipt, s.requiresLogin)
+	gOrders.DELETE("/:id", s.OrderDelete, s.requiresLogin, s.idempotent)
+	gOrders.POST("/:id/line/", s.OrderLineCreate, s.requiresLogin, s.idempotent)
+	gOrders.PATCH("/:id/line/:lineid", s.OrderLineModify, s.requiresLogin, s.idempotent)
+	gOrders.DELETE("/:id/line/:lineid", s.OrderLineDelete, s.requiresLogin, s.idempotent)
+	gOrders.PUT("/:id/coupon", s.OrderCouponApply, s.requiresLogin, s.idempotent)
+	gOrders.DELETE("/:id/coupon", s.OrderCouponRemove, s.requiresLogin, s.idempotent)
+	gOrders.POST("/:id/checkout", s.OrderCheckout, s.requiresLogin, s.idempotent)
 	s.e.GET("/orders", s.OrderList, s.requiresLogin)
 	s.e.GET("/orders/count", s.OrderCount, s.requiresLogin, requiresAdministrator)
+	s.e.GET("/orders/kitchen", s.OrderKitchen, s.requiresLogin, requiresAdministrator)
+
+	// Invoices API - PDF receipts and monthly invoices, numbered when first downloaded
+	gInvoices := s.e.Group("/invoice")
+	gInvoices.GET("/monthly", s.InvoiceMonthly, s.requiresLogin)
+	gInvoices.GET("/:id", s.InvoiceDetails, s.requiresLogin)
+	s.e.GET("/invoices", s.InvoiceList, s.requiresLogin)
+
+	// Payments API
+	s.e.POST("/payments/webhook", s.PaymentWebhook)
+
+	// Subscriptions API
+	gSubscriptions := s.e.Group("/subscription")
+	gSubscriptions.GET("/:id", s.SubscriptionDetails, s.requiresLogin)
+	gSubscriptions.POST("/", s.SubscriptionCreate, s.requiresLogin)
+	gSubscriptions.PATCH("/:id", s.SubscriptionModify, s.requiresLogin)
+	gSubscriptions.DELETE("/:id", s.SubscriptionDelete, s.requiresLogin)
+	gSubscriptions.POST("/:id/pause", s.SubscriptionPause, s.requiresLogin)
+	gSubscriptions.POST("/:id/resume", s.SubscriptionResume, s.requiresLogin)
+	gSubscriptions.POST("/:id/skip/:day", s.SubscriptionSkip, s.requiresLogin)
+	gSubscriptions.DELETE("/:id/skip/:day", s.SubscriptionUnskip, s.requiresLogin)
+	s.e.GET("/subscriptions", s.SubscriptionList, s.requiresLogin)
+
+	// Notifications API
+	s.e.GET("/notifications", s.NotificationList, s.requiresLogin)
+	s.e.POST("/notification/:id/read", s.NotificationRead, s.requiresLogin)
 
 	return s.e.Start(fmt.Sprintf(`:%d`, s.cfg.Port))
 }
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"tfm_backend/models"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+func (s *Server) SetMenuCreate(c echo.Context) error {
+	var setMenu models.SetMenu
+	err := c.Bind(&setMenu)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to bind set menu")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	setMenu, err = s.db.SetMenuCreate(setMenu)
+	if err != nil {
+		log.Error().Err(err).Interface("setMenu", setMenu).Msg("Failed to create set menu")
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, setMenu)
+}
+
+func (s *Server) SetMenuDelete(c echo.Context) error {
+	setMenuId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	err = s.db.SetMenuDelete(setMenuId)
+	if err != nil {
+		log.Error().Err(err).Uint64("id", setMenuId).Msg("Failed to delete set menu")
+		return err
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+func (s *Server) SetMenuDetails(c echo.Context) error {
+	setMenuId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	setMenu, err := s.db.SetMenuDetails(setMenuId)
+	if err != nil {
+		log.Error().Err(err).Uint64("id", setMenuId).Msg("Failed to read set menu")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, setMenu)
+}
+
+func (s *Server) SetMenuList(c echo.Context) error {
+	limit, page, offset := parsePagination(c)
+
+	setMenus, err := s.db.SetMenuList(limit, offset)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list set menus")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, models.PaginationSetMenus{Limit: limit, Page: page, SetMenus: setMenus})
+}
+
+func (s *Server) SetMenuModify(c echo.Context) error {
+	setMenuId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var setMenu models.SetMenu
+	err = c.Bind(&setMenu)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to bind set menu")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	setMenu.ID = setMenuId
+
+	setMenu, err = s.db.SetMenuModify(setMenu)
+	if err != nil {
+		log.Error().Err(err).Interface("setMenu", setMenu).Msg("Failed to modify set menu")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, setMenu)
+}
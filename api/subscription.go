@@ -0,0 +1,153 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"tfm_backend/models"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+func (s *Server) SubscriptionCreate(c echo.Context) error {
+	var subscription models.Subscription
+	err := c.Bind(&subscription)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to bind subscription")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	subscription.ID = 0
+	subscription.UserID = authenticatedUserId(c)
+
+	subscription, err = s.db.SubscriptionCreate(subscription)
+	if err != nil {
+		log.Error().Err(err).Interface("subscription", subscription).Msg("Failed to create subscription")
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, subscription)
+}
+
+func (s *Server) SubscriptionDelete(c echo.Context) error {
+	subscriptionId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	err = s.db.SubscriptionDelete(authenticatedUserId(c), subscriptionId)
+	if err != nil {
+		log.Error().Err(err).Uint64("id", subscriptionId).Msg("Failed to delete subscription")
+		return err
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+func (s *Server) SubscriptionDetails(c echo.Context) error {
+	subscriptionId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	subscription, err := s.db.SubscriptionDetails(authenticatedUserId(c), subscriptionId)
+	if err != nil {
+		log.Error().Err(err).Uint64("id", subscriptionId).Msg("Failed to read subscription")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, subscription)
+}
+
+func (s *Server) SubscriptionList(c echo.Context) error {
+	limit, page, offset := parsePagination(c)
+
+	subscriptions, err := s.db.SubscriptionList(authenticatedUserId(c), limit, offset)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list subscriptions")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, models.PaginationSubscriptions{Limit: limit, Page: page, Subscriptions: subscriptions})
+}
+
+func (s *Server) SubscriptionModify(c echo.Context) error {
+	subscriptionId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var subscription models.Subscription
+	err = c.Bind(&subscription)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to bind subscription")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	subscription.ID = subscriptionId
+	subscription.UserID = authenticatedUserId(c)
+
+	subscription, err = s.db.SubscriptionModify(subscription)
+	if err != nil {
+		log.Error().Err(err).Interface("subscription", subscription).Msg("Failed to modify subscription")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, subscription)
+}
+
+func (s *Server) SubscriptionPause(c echo.Context) error {
+	return s.subscriptionPause(c, true)
+}
+
+func (s *Server) SubscriptionResume(c echo.Context) error {
+	return s.subscriptionPause(c, false)
+}
+
+func (s *Server) SubscriptionSkip(c echo.Context) error {
+	return s.subscriptionSkip(c, true)
+}
+
+func (s *Server) SubscriptionUnskip(c echo.Context) error {
+	return s.subscriptionSkip(c, false)
+}
+
+func (s *Server) subscriptionPause(c echo.Context, paused bool) error {
+	subscriptionId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	subscription, err := s.db.SubscriptionPause(authenticatedUserId(c), subscriptionId, paused)
+	if err != nil {
+		log.Error().Err(err).Uint64("id", subscriptionId).Bool("paused", paused).Msg("Failed to pause subscription")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, subscription)
+}
+
+func (s *Server) subscriptionSkip(c echo.Context, skip bool) error {
+	subscriptionId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	day, err := time.ParseInLocation("2006-01-02", c.Param("day"), time.Now().Location())
+	if err != nil {
+		log.Error().Err(err).Str("day", c.Param("day")).Msg("Failed to parse skip day")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	subscription, err := s.db.SubscriptionSkip(authenticatedUserId(c), subscriptionId, day, skip)
+	if err != nil {
+		log.Error().Err(err).Uint64("id", subscriptionId).Time("day", day).Bool("skip", skip).Msg("Failed to skip subscription day")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, subscription)
+}
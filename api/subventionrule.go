@@ -0,0 +1,96 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"tfm_backend/models"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+func (s *Server) SubventionRuleCreate(c echo.Context) error {
+	var rule models.SubventionRule
+	err := c.Bind(&rule)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to bind subvention rule")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	rule, err = s.db.SubventionRuleCreate(rule)
+	if err != nil {
+		log.Error().Err(err).Interface("rule", rule).Msg("Failed to create subvention rule")
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, rule)
+}
+
+func (s *Server) SubventionRuleDelete(c echo.Context) error {
+	ruleId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	err = s.db.SubventionRuleDelete(ruleId)
+	if err != nil {
+		log.Error().Err(err).Uint64("id", ruleId).Msg("Failed to delete subvention rule")
+		return err
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+func (s *Server) SubventionRuleDetails(c echo.Context) error {
+	ruleId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	rule, err := s.db.SubventionRuleDetails(ruleId)
+	if err != nil {
+		log.Error().Err(err).Uint64("id", ruleId).Msg("Failed to read subvention rule")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, rule)
+}
+
+func (s *Server) SubventionRuleList(c echo.Context) error {
+	limit, page, offset := parsePagination(c)
+
+	rules, err := s.db.SubventionRuleList(limit, offset)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list subvention rules")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, models.PaginationSubventionRules{Limit: limit, Page: page, SubventionRules: rules})
+}
+
+// The whole rule is replaced, fields not sent are cleared
+func (s *Server) SubventionRuleModify(c echo.Context) error {
+	ruleId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var rule models.SubventionRule
+	err = c.Bind(&rule)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to bind subvention rule")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	rule.ID = ruleId
+
+	rule, err = s.db.SubventionRuleModify(rule)
+	if err != nil {
+		log.Error().Err(err).Interface("rule", rule).Msg("Failed to modify subvention rule")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, rule)
+}
@@ -0,0 +1,132 @@
+package api
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"tfm_backend/models"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+func (s *Server) TranslationDelete(c echo.Context) error {
+	translationId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	err = s.db.TranslationDelete(translationId)
+	if err != nil {
+		log.Error().Err(err).Uint64("id", translationId).Msg("Failed to delete translation")
+		return err
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+func (s *Server) TranslationList(c echo.Context) error {
+	entityId, _ := strconv.ParseUint(c.QueryParam("entityId"), 10, 64)
+
+	translations, err := s.db.TranslationList(c.QueryParam("entity"), entityId, c.QueryParam("lang"))
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list translations")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, translations)
+}
+
+func (s *Server) TranslationSave(c echo.Context) error {
+	var translation models.Translation
+	err := c.Bind(&translation)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to bind translation")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if !s.languageSupported(translation.Language) {
+		return echo.NewHTTPError(http.StatusBadRequest, "Language not supported")
+	}
+
+	translation, err = s.db.TranslationSave(translation)
+	if err != nil {
+		log.Error().Err(err).Interface("translation", translation).Msg("Failed to save translation")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, translation)
+}
+
+// Language of the response: lang query parameter, Accept-Language header or default language
+func (s *Server) requestLanguage(c echo.Context) string {
+	language := s.cfg.DefaultLanguage
+
+	if lang := strings.ToLower(c.QueryParam("lang")); s.languageSupported(lang) {
+		language = lang
+	} else {
+		for _, lang := range parseAcceptLanguage(c.Request().Header.Get("Accept-Language")) {
+			if s.languageSupported(lang) {
+				language = lang
+				break
+			}
+			// en-US -> en
+			if primary, _, found := strings.Cut(lang, "-"); found && s.languageSupported(primary) {
+				language = primary
+				break
+			}
+		}
+	}
+
+	c.Response().Header().Set("Content-Language", language)
+	return language
+}
+
+func (s *Server) languageSupported(language string) bool {
+	if len(language) == 0 {
+		return false
+	}
+
+	for _, supported := range s.cfg.Languages {
+		if supported == language {
+			return true
+		}
+	}
+	return language == s.cfg.DefaultLanguage
+}
+
+// Returns the languages of an Accept-Language header, sorted by quality
+func parseAcceptLanguage(header string) []string {
+	type weighted struct {
+		language string
+		quality  float64
+	}
+
+	var languages []weighted
+	for _, part := range strings.Split(header, ",") {
+		lang, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if len(lang) == 0 || lang == "*" {
+			continue
+		}
+
+		var quality float64 = 1
+		if q, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			quality, _ = strconv.ParseFloat(q, 64)
+		}
+		if quality > 0 {
+			languages = append(languages, weighted{language: strings.ToLower(lang), quality: quality})
+		}
+	}
+
+	sort.SliceStable(languages, func(i, j int) bool {
+		return languages[i].quality > languages[j].quality
+	})
+
+	result := make([]string, 0, len(languages))
+	for _, l := range languages {
+		result = append(result, l.language)
+	}
+	return result
+}
@@ -29,6 +29,10 @@ func (s *Server) UserCreate(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
 
+	// signing up doesn't make the company pay - administrators assign it, and the office days
+	user.CompanyID = 0
+	user.OfficeDays = ""
+
 	if len(user.Password) > 0 {
 		user.Password = stringToSha512(user.Password)
 	}
@@ -135,6 +139,9 @@ func (s *Server) UserModify(c echo.Context) error {
 			log.Warn().Uint64("authUserId", authUserId).Uint64("userId", userId).Msg(`A Non-Admin user is trying to modify another user`)
 			return echo.NewHTTPError(http.StatusForbidden, `Only an Administrator can modify another user`)
 		}
+		// the company pays the meals, only administrators assign it and the office days that get subventions
+		user.CompanyID = 0
+		user.OfficeDays = ""
 	}
 
 	// If we have a new password, we generate the hash
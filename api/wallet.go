@@ -0,0 +1,127 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"tfm_backend/models"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+// Own wallet, or the wallet of another user for administrators (?user=)
+func (s *Server) WalletDetails(c echo.Context) error {
+	userId, err := walletUserId(c)
+	if err != nil {
+		return err
+	}
+
+	wallet, err := s.db.WalletDetails(userId)
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", userId).Msg("Failed to read wallet")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, wallet)
+}
+
+func (s *Server) WalletLimit(c echo.Context) error {
+	userId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var request models.WalletLimitRequest
+	err = c.Bind(&request)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to bind wallet limit")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	wallet, err := s.db.WalletLimit(userId, request.CreditLimit)
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", userId).Msg("Failed to change wallet credit limit")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, wallet)
+}
+
+func (s *Server) WalletStatement(c echo.Context) error {
+	userId, err := walletUserId(c)
+	if err != nil {
+		return err
+	}
+
+	month, err := parseMonth(c)
+	if err != nil {
+		return err
+	}
+
+	statement, err := s.db.WalletStatement(userId, month)
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", userId).Time("month", month).Msg("Failed to get wallet statement")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, statement)
+}
+
+func (s *Server) WalletTransactionCreate(c echo.Context) error {
+	userId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("id", c.Param("id")).Msg(msgErrorIdToInt)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var request models.WalletTransactionRequest
+	err = c.Bind(&request)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to bind wallet transaction")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	transaction, err := s.db.WalletTransactionCreate(authenticatedUserId(c), userId, request)
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", userId).Interface("request", request).Msg("Failed to create wallet transaction")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return c.JSON(http.StatusCreated, transaction)
+}
+
+func (s *Server) WalletTransactionList(c echo.Context) error {
+	userId, err := walletUserId(c)
+	if err != nil {
+		return err
+	}
+
+	limit, page, offset := parsePagination(c)
+
+	transactions, err := s.db.WalletTransactionList(userId, limit, offset)
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", userId).Msg("Failed to list wallet transactions")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, models.PaginationWalletTransactions{Limit: limit, Page: page, Transactions: transactions})
+}
+
+// Users see their own wallet, administrators can see any with ?user=
+func walletUserId(c echo.Context) (uint64, error) {
+	if len(c.QueryParam("user")) == 0 {
+		return authenticatedUserId(c), nil
+	}
+
+	userId, err := strconv.ParseUint(c.QueryParam("user"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("user", c.QueryParam("user")).Msg(msgErrorIdToInt)
+		return 0, echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if userId != authenticatedUserId(c) && !authenticatedIsAdministrator(c) {
+		return 0, echo.NewHTTPError(http.StatusForbidden, `Only an Administrator can see the wallet of another user`)
+	}
+
+	return userId, nil
+}
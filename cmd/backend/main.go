@@ -8,6 +8,7 @@ import (
 	"github.com/rs/zerolog/log"
 
 	"tfm_backend/api"
+	"tfm_backend/jobs"
 	"tfm_backend/models"
 	"tfm_backend/orm"
 )
@@ -32,6 +33,8 @@ func main() {
 		return
 	}
 
+	jobs.NewScheduler(database).Start()
+
 	err = server.Listen()
 	if err != nil {
 		log.Error().Err(err).Msg("Faile to listen REST API")
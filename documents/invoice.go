@@ -0,0 +1,150 @@
+package documents
+
+import (
+	"fmt"
+	"strconv"
+	"tfm_backend/models"
+)
+
+const (
+	marginLeft   = 50.0
+	marginRight  = PageWidth - 50
+	marginTop    = PageHeight - 50
+	marginBottom = 70.0
+	rowHeight    = 14.0
+)
+
+// Columns of the lines table - right edges of the numbers
+const (
+	columnQuantity  = 380.0
+	columnUnitPrice = 460.0
+)
+
+// PDF of a receipt or monthly invoice, from the content stored when it was issued
+func InvoicePDF(content models.InvoiceContent) []byte {
+	w := invoiceWriter{pdf: NewPDF(), y: marginTop}
+
+	title := "Invoice"
+	if content.Type == models.InvoiceReceipt {
+		title = "Receipt"
+	}
+	w.pdf.Text(marginLeft, w.y-14, 20, true, title)
+	w.pdf.TextRight(marginRight, w.y, 11, true, "No. "+content.Number)
+	w.pdf.TextRight(marginRight, w.y-rowHeight, 10, false, "Date: "+content.Issued.Format("2006-01-02"))
+	w.pdf.TextRight(marginRight, w.y-2*rowHeight, 10, false, "Period: "+content.Period)
+	w.y -= 70
+
+	issuerEnd := w.party(marginLeft, "From", content.Issuer)
+	customerEnd := w.party(320, "To", content.Customer)
+	w.y = min(issuerEnd, customerEnd) - 20
+
+	w.header()
+	for _, line := range content.Lines {
+		w.line(line, false)
+	}
+	for _, line := range content.Adjustments {
+		w.line(line, false)
+	}
+
+	w.space(3)
+	w.rule()
+	w.line(models.InvoiceLine{Description: "Total (taxes included)", Amount: content.Total}, true)
+	for _, tax := range content.Taxes {
+		w.line(models.InvoiceLine{
+			Description: fmt.Sprintf("VAT %s%% included, on a base of %s", strconv.FormatFloat(tax.Rate, 'f', -1, 64), money(tax.Base)),
+			Amount:      tax.Tax,
+		}, false)
+	}
+
+	if len(content.Settlement) > 0 {
+		w.y -= rowHeight
+		w.space(len(content.Settlement) + 1)
+		w.pdf.Text(marginLeft, w.y, 10, true, "Paid with")
+		w.y -= rowHeight
+		for _, line := range content.Settlement {
+			w.line(line, false)
+		}
+	}
+
+	return w.pdf.Bytes()
+}
+
+type invoiceWriter struct {
+	pdf *PDF
+	y   float64 // baseline of the next row
+}
+
+// Name, tax id and address of the issuer or customer - returns the y below it
+func (w *invoiceWriter) party(x float64, label string, party models.InvoiceParty) float64 {
+	y := w.y
+	w.pdf.Text(x, y, 9, false, label)
+	y -= rowHeight
+	w.pdf.Text(x, y, 11, true, truncate(party.Name, 225, 11))
+	y -= rowHeight
+
+	var rows []string
+	if len(party.TaxID) > 0 {
+		rows = append(rows, "Tax ID: "+party.TaxID)
+	}
+	rows = append(rows, party.Address...)
+	if len(party.Email) > 0 {
+		rows = append(rows, party.Email)
+	}
+	for _, row := range rows {
+		w.pdf.Text(x, y, 10, false, truncate(row, 225, 10))
+		y -= rowHeight
+	}
+
+	return y
+}
+
+func (w *invoiceWriter) header() {
+	w.pdf.Text(marginLeft, w.y, 10, true, "Description")
+	w.pdf.TextRight(columnQuantity, w.y, 10, true, "Qty")
+	w.pdf.TextRight(columnUnitPrice, w.y, 10, true, "Unit price")
+	w.pdf.TextRight(marginRight, w.y, 10, true, "Amount")
+	w.y -= 6
+	w.rule()
+}
+
+func (w *invoiceWriter) line(line models.InvoiceLine, bold bool) {
+	w.space(1)
+	w.pdf.Text(marginLeft, w.y, 10, bold, truncate(line.Description, columnQuantity-marginLeft-40, 10))
+	if line.Quantity > 0 {
+		w.pdf.TextRight(columnQuantity, w.y, 10, bold, strconv.FormatUint(uint64(line.Quantity), 10))
+		w.pdf.TextRight(columnUnitPrice, w.y, 10, bold, money(line.UnitPrice))
+	}
+	w.pdf.TextRight(marginRight, w.y, 10, bold, money(line.Amount))
+	w.y -= rowHeight
+}
+
+func (w *invoiceWriter) rule() {
+	w.pdf.Line(marginLeft, w.y, marginRight, w.y)
+	w.y -= rowHeight
+}
+
+// Starts a new page, with the table header, when the rows don't fit
+func (w *invoiceWriter) space(rows int) {
+	if w.y-float64(rows)*rowHeight >= marginBottom {
+		return
+	}
+	w.pdf.AddPage()
+	w.y = marginTop
+	w.header()
+}
+
+func money(m models.Money) string {
+	return m.String() + " €"
+}
+
+// Cuts the text to the width, ending it with ...
+func truncate(text string, width float64, size float64) string {
+	if TextWidth(text, size) <= width {
+		return text
+	}
+	runes := []rune(text)
+	for len(runes) > 0 && TextWidth(string(runes)+"...", size) > width {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + "..."
+}
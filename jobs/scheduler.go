@@ -0,0 +1,58 @@
+package jobs
+
+import (
+	"time"
+
+	"tfm_backend/orm"
+
+	"github.com/rs/zerolog/log"
+)
+
+type Scheduler struct {
+	db   *orm.Database
+	jobs []job
+}
+
+type job struct {
+	name  string
+	every time.Duration
+	run   func() error
+}
+
+func NewScheduler(db *orm.Database) *Scheduler {
+	s := Scheduler{db: db}
+
+	s.jobs = append(s.jobs, job{name: "dish prices", every: time.Minute, run: db.DishPriceApply})
+	s.jobs = append(s.jobs, job{name: "recommendations", every: time.Hour, run: db.DishRecommendationsRefresh})
+	s.jobs = append(s.jobs, job{name: "suggestions", every: time.Hour, run: db.DishSuggestionsRefresh})
+	s.jobs = append(s.jobs, job{name: "subscriptions", every: 15 * time.Minute, run: db.SubscriptionsMaterialize})
+	s.jobs = append(s.jobs, job{name: "idempotency keys", every: time.Hour, run: db.IdempotencyKeysPurge})
+	s.jobs = append(s.jobs, job{name: "payments capture", every: time.Minute, run: db.PaymentsCapture})
+	s.jobs = append(s.jobs, job{name: "payment refunds", every: time.Minute, run: db.PaymentRefundsProcess})
+
+	return &s
+}
+
+// Runs every job in its own goroutine, first run is immediate
+func (s *Scheduler) Start() {
+	for _, j := range s.jobs {
+		go s.loop(j)
+	}
+}
+
+func (s *Scheduler) loop(j job) {
+	ticker := time.NewTicker(j.every)
+	defer ticker.Stop()
+
+	for {
+		start := time.Now()
+		err := j.run()
+		if err != nil {
+			log.Error().Err(err).Str("job", j.name).Msg("Background job failed")
+		} else {
+			log.Debug().Str("job", j.name).Dur("duration", time.Since(start)).Msg("Background job finished")
+		}
+
+		<-ticker.C
+	}
+}
@@ -0,0 +1,91 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Catalog objects are matched by name, so a catalog can be moved between environments
+type Catalog struct {
+	Categories  []CatalogCategory   `json:"categories"` // parents before their subcategories
+	Ingredients []CatalogIngredient `json:"ingredients"`
+	Allergens   []string            `json:"allergens"`
+	Dishes      []CatalogDish       `json:"dishes"`
+}
+
+// Parent and Position are nil in catalogs without the category tree - the category keeps its place
+type CatalogCategory struct {
+	Name       string             `json:"name"`
+	Parent     *string            `json:"parent"` // empty = top level section
+	Position   *uint              `json:"position"`
+	Promotions []CatalogPromotion `json:"promotions"` // promotions of the dishes of the category and its subcategories
+}
+
+// Allergens and Traces are nil in catalogs without them - the ingredient keeps its allergens
+type CatalogIngredient struct {
+	Name      string   `json:"name"`
+	Allergens []string `json:"allergens"`
+	Traces    []string `json:"traces"`
+}
+
+type CatalogDish struct {
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Cost        Money              `json:"cost"`
+	Categories  []string           `json:"categories"`
+	Ingredients []string           `json:"ingredients"`
+	Allergens   []string           `json:"allergens"` // extra allergens, the ingredients add the rest
+	Promotions  []CatalogPromotion `json:"promotions"`
+}
+
+// Promotions are matched by type, dates, happy hour and week days
+type CatalogPromotion struct {
+	Name       string    `json:"name"`
+	Type       string    `json:"type"` // price when empty
+	StartTime  time.Time `json:"startTime"`
+	EndTime    time.Time `json:"endTime"`
+	DailyStart string    `json:"dailyStart"`
+	DailyEnd   string    `json:"dailyEnd"`
+	Weekdays   string    `json:"weekdays"`
+	Cost       Money     `json:"cost"`
+	Percentage float64   `json:"percentage"`
+	Amount     Money     `json:"amount"`
+	Buy        uint      `json:"buy"`
+	Pay        uint      `json:"pay"`
+}
+
+// Catalogs exported before the category tree only have the names
+func (c *CatalogCategory) UnmarshalJSON(data []byte) error {
+	var name string
+	if json.Unmarshal(data, &name) == nil {
+		*c = CatalogCategory{Name: name}
+		return nil
+	}
+
+	type plain CatalogCategory
+	return json.Unmarshal(data, (*plain)(c))
+}
+
+// Catalogs exported before ingredient allergens only have the names
+func (i *CatalogIngredient) UnmarshalJSON(data []byte) error {
+	var name string
+	if json.Unmarshal(data, &name) == nil {
+		*i = CatalogIngredient{Name: name}
+		return nil
+	}
+
+	type plain CatalogIngredient
+	return json.Unmarshal(data, (*plain)(i))
+}
+
+type CatalogChange struct {
+	Entity  string   `json:"entity"`
+	Name    string   `json:"name"`
+	Action  string   `json:"action"` // create, update
+	Changes []string `json:"changes,omitempty"`
+}
+
+type CatalogImport struct {
+	DryRun  bool            `json:"dryRun"`
+	Changes []CatalogChange `json:"changes"`
+}
@@ -0,0 +1,7 @@
+package models
+
+// New order of the children of a category, the position of every category is its index in IDs
+type CategoryOrder struct {
+	ParentID uint64   `json:"parentId"` // 0 = top level sections
+	IDs      []uint64 `json:"ids"`
+}
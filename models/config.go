@@ -5,6 +5,7 @@ type Config struct {
 	Server     ConfigServer   `json:"server"`
 	SiteAdmin  User           `json:"site_admin"`
 	SiteConfig Configuration  `json:"site_config"`
+	Payments   ConfigPayments `json:"payments"`
 }
 
 type ConfigDatabase struct {
@@ -19,6 +20,13 @@ type ConfigDatabase struct {
 }
 
 type ConfigServer struct {
-	Port      int    `json:"port"`
-	JWTSecret string `json:"jwt_secret"`
+	Port            int      `json:"port"`
+	JWTSecret       string   `json:"jwt_secret"`
+	DefaultLanguage string   `json:"default_language"`
+	Languages       []string `json:"languages"`
+}
+
+type ConfigPayments struct {
+	Provider      string `json:"provider"`       // "fake" charges nothing, for development
+	WebhookSecret string `json:"webhook_secret"` // signs the notifications sent by the provider
 }
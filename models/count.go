@@ -1,6 +1,20 @@
 package models
 
 type CountOrders struct {
-	Day   string `json:"day"`
-	Count uint64 `json:"count"`
+	Day       string `json:"day"`
+	Count     uint64 `json:"count"`
+	Cancelled uint64 `json:"cancelled"` // included in Count
+}
+
+type CountKitchenDishes struct {
+	DishID    uint64                  `json:"dishId"`
+	Name      string                  `json:"name"`
+	Quantity  uint64                  `json:"quantity"`
+	Modifiers []CountKitchenModifiers `json:"modifiers" gorm:"-"` // how many of the dishes have each modifier
+}
+
+type CountKitchenModifiers struct {
+	DishID   uint64 `json:"-"`
+	Name     string `json:"name"`
+	Quantity uint64 `json:"quantity"`
 }
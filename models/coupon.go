@@ -0,0 +1,5 @@
+package models
+
+type CouponApply struct {
+	Code string `json:"code"`
+}
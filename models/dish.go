@@ -0,0 +1,5 @@
+package models
+
+type DishStockRequest struct {
+	Stock *uint `json:"stock"` // null = unlimited
+}
@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+// Everything printed on a receipt or invoice, stored when it is issued
+type InvoiceContent struct {
+	Type        string        `json:"type"`
+	Number      string        `json:"number"`
+	Issued      time.Time     `json:"issued"`
+	Period      string        `json:"period"` // delivery day of receipts, month of invoices
+	Issuer      InvoiceParty  `json:"issuer"`
+	Customer    InvoiceParty  `json:"customer"`
+	Lines       []InvoiceLine `json:"lines"`
+	Adjustments []InvoiceLine `json:"adjustments"` // promotions and coupons, negative
+	Total       Money         `json:"total"`       // taxes included
+	Taxes       []InvoiceTax  `json:"taxes"`
+	Settlement  []InvoiceLine `json:"settlement"` // how the total is paid - subvention, card, meal wallet...
+}
+
+type InvoiceParty struct {
+	Name    string   `json:"name"`
+	TaxID   string   `json:"taxId"`
+	Email   string   `json:"email"`
+	Address []string `json:"address"`
+}
+
+type InvoiceLine struct {
+	Description string `json:"description"`
+	Quantity    uint   `json:"quantity"`  // 0 = not printed
+	UnitPrice   Money  `json:"unitPrice"` // printed with the quantity
+	Amount      Money  `json:"amount"`
+}
+
+type InvoiceTax struct {
+	Rate float64 `json:"rate"`
+	Base Money   `json:"base"`
+	Tax  Money   `json:"tax"`
+}
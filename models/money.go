@@ -0,0 +1,131 @@
+package models
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Amount of money in cents, so sums and discounts are exact.
+// Stored as numeric(12,2), and encoded in JSON as a number with two decimals like the old float prices.
+// Fractions of a cent are rounded half away from zero (2.345 -> 2.35, -2.345 -> -2.35).
+type Money int64
+
+// Rounds to the nearest cent
+func MoneyFromFloat(f float64) Money {
+	return Money(math.Round(f * 100))
+}
+
+// Parses a decimal amount, "12.5", "-3.99" or "1e2" - digits after the cents are rounded
+func ParseMoney(s string) (Money, error) {
+	s = strings.TrimSpace(s)
+	if strings.ContainsAny(s, "eE") {
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return 0, err
+		}
+		return MoneyFromFloat(f), nil
+	}
+
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimLeft(s, "+-")
+	units, fraction, _ := strings.Cut(s, ".")
+	if len(units) == 0 && len(fraction) == 0 {
+		return 0, fmt.Errorf("invalid amount of money %q", s)
+	}
+
+	digits := units + (fraction + "00")[:2]
+	cents, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount of money %q", s)
+	}
+	if len(fraction) > 2 {
+		if strings.Trim(fraction[2:], "0123456789") != "" {
+			return 0, fmt.Errorf("invalid amount of money %q", s)
+		}
+		if fraction[2] >= '5' {
+			cents++
+		}
+	}
+
+	if negative {
+		cents = -cents
+	}
+	return Money(cents), nil
+}
+
+// Cost of quantity units
+func (m Money) Times(quantity uint) Money {
+	return m * Money(quantity)
+}
+
+// Percentage (0 to 100) of the amount, rounded to the cent
+func (m Money) Percentage(percentage float64) Money {
+	return Money(math.Round(float64(m) * percentage / 100))
+}
+
+func (m Money) Float() float64 {
+	return float64(m) / 100
+}
+
+func (m Money) String() string {
+	sign := ""
+	cents := int64(m)
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// Accepts numbers, and numbers in strings
+func (m *Money) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "null" || len(s) == 0 {
+		*m = 0
+		return nil
+	}
+
+	money, err := ParseMoney(s)
+	if err != nil {
+		return err
+	}
+	*m = money
+	return nil
+}
+
+func (m *Money) Scan(value interface{}) error {
+	var err error
+
+	switch v := value.(type) {
+	case nil:
+		*m = 0
+	case []byte:
+		*m, err = ParseMoney(string(v))
+	case string:
+		*m, err = ParseMoney(v)
+	case float64:
+		*m = MoneyFromFloat(v)
+	case int64:
+		*m = Money(v * 100)
+	default:
+		err = errors.New(fmt.Sprint("cannot scan money from ", value))
+	}
+
+	return err
+}
+
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+func (Money) GormDataType() string {
+	return "numeric(12,2)"
+}
@@ -0,0 +1,166 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Money
+	}{
+		{"0", 0},
+		{"12", 1200},
+		{"12.5", 1250},
+		{"12.50", 1250},
+		{"-3.99", -399},
+		{"+3.99", 399},
+		{".5", 50},
+		{"7.", 700},
+		{" 6.50 ", 650},
+		{"1e2", 10000},
+		{"2.345", 235},
+		{"2.344", 234},
+		{"-2.345", -235},
+		{"0.999", 100},
+	}
+
+	for _, test := range tests {
+		got, err := ParseMoney(test.input)
+		if err != nil {
+			t.Errorf("ParseMoney(%q) returned error %v", test.input, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("ParseMoney(%q) = %d, want %d", test.input, got, test.want)
+		}
+	}
+}
+
+func TestParseMoneyInvalid(t *testing.T) {
+	for _, input := range []string{"", "-", ".", "abc", "1.2x", "1,50", "1.234abc", "e5"} {
+		_, err := ParseMoney(input)
+		if err == nil {
+			t.Errorf("ParseMoney(%q) should fail", input)
+		}
+	}
+}
+
+func TestMoneyRounding(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Money
+		want Money
+	}{
+		{"from float", MoneyFromFloat(0.1 + 0.2), 30},
+		{"from float half up", MoneyFromFloat(2.675), 268},
+		{"from negative float", MoneyFromFloat(-1.005), -100},
+		{"times", Money(199).Times(3), 597},
+		{"percentage", Money(1000).Percentage(15), 150},
+		{"percentage rounded", Money(999).Percentage(50), 500},
+		{"percentage of negative", Money(-999).Percentage(50), -500},
+	}
+
+	for _, test := range tests {
+		if test.got != test.want {
+			t.Errorf("%s = %d, want %d", test.name, test.got, test.want)
+		}
+	}
+}
+
+func TestMoneyString(t *testing.T) {
+	tests := []struct {
+		money Money
+		want  string
+	}{
+		{0, "0.00"},
+		{5, "0.05"},
+		{1250, "12.50"},
+		{-399, "-3.99"},
+		{-5, "-0.05"},
+	}
+
+	for _, test := range tests {
+		if got := test.money.String(); got != test.want {
+			t.Errorf("Money(%d).String() = %q, want %q", test.money, got, test.want)
+		}
+	}
+}
+
+func TestMoneyJSON(t *testing.T) {
+	type priced struct {
+		Cost Money `json:"cost"`
+	}
+
+	data, err := json.Marshal(priced{Cost: 650})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"cost":6.50}` {
+		t.Errorf("marshal = %s", data)
+	}
+
+	var decoded priced
+	err = json.Unmarshal(data, &decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Cost != 650 {
+		t.Errorf("round trip = %d, want 650", decoded.Cost)
+	}
+
+	for input, want := range map[string]Money{`{"cost":"4.25"}`: 425, `{"cost":null}`: 0, `{"cost":3}`: 300, `{"cost":1.999}`: 200} {
+		decoded = priced{Cost: 1}
+		err = json.Unmarshal([]byte(input), &decoded)
+		if err != nil {
+			t.Errorf("unmarshal %s returned error %v", input, err)
+			continue
+		}
+		if decoded.Cost != want {
+			t.Errorf("unmarshal %s = %d, want %d", input, decoded.Cost, want)
+		}
+	}
+
+	err = json.Unmarshal([]byte(`{"cost":"free"}`), &decoded)
+	if err == nil {
+		t.Error("unmarshal of an invalid amount should fail")
+	}
+}
+
+// numeric(12,2) columns are scanned as text by the driver
+func TestMoneyScan(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		want  Money
+	}{
+		{[]byte("12.50"), 1250},
+		{"-0.01", -1},
+		{"9999999999.99", 999999999999},
+		{float64(3.3), 330},
+		{int64(4), 400},
+		{nil, 0},
+	}
+
+	for _, test := range tests {
+		m := Money(1)
+		err := m.Scan(test.value)
+		if err != nil {
+			t.Errorf("Scan(%v) returned error %v", test.value, err)
+			continue
+		}
+		if m != test.want {
+			t.Errorf("Scan(%v) = %d, want %d", test.value, m, test.want)
+		}
+	}
+
+	var m Money
+	if err := m.Scan(true); err == nil {
+		t.Error("Scan(bool) should fail")
+	}
+
+	value, err := Money(1250).Value()
+	if err != nil || value != "12.50" {
+		t.Errorf("Value() = %v, %v", value, err)
+	}
+}
@@ -0,0 +1,39 @@
+package models
+
+import "time"
+
+// Price breakdown of a prospective order, computed as OrderCreate would do it
+type OrderQuote struct {
+	Lines      []OrderQuoteLine `json:"lines"`
+	Discounts  []OrderDiscount  `json:"discounts"` // order promotions (multi-buy)
+	Subtotal   Money            `json:"subtotal"`  // lines, before order promotions
+	Discount   Money            `json:"discount"`
+	CostTotal  Money            `json:"costTotal"`
+	Subvention Money            `json:"subvention"`
+	CostToPay  Money            `json:"costToPay"`
+	Delivery   time.Time        `json:"delivery"`
+	Warnings   []string         `json:"warnings"` // the order could not be created as quoted, or not with these prices
+}
+
+type OrderQuoteLine struct {
+	DishID        uint64 `json:"dishId"`
+	SetMenuID     uint64 `json:"setMenuId"`
+	Name          string `json:"name"`
+	Quantity      uint   `json:"quantity"`
+	BasePrice     Money  `json:"basePrice"` // dish or set menu price, without promotions
+	Promotion     string `json:"promotion"` // promotion applied to the unit price (empty = none)
+	PromotionID   uint64 `json:"promotionId"`
+	ModifiersCost Money  `json:"modifiersCost"` // added by the modifiers chosen
+	CostUnit      Money  `json:"costUnit"`
+	Cost          Money  `json:"cost"` // CostUnit x Quantity
+}
+
+type OrderCancelRequest struct {
+	Reason  string `json:"reason"` // customers always cancel with "customer"
+	Comment string `json:"comment"`
+}
+
+type OrderStatusRequest struct {
+	Status  string `json:"status"`
+	Comment string `json:"comment"`
+}
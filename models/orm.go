@@ -15,9 +15,17 @@ type BaseModel struct {
 
 type Configuration struct {
 	BaseModel
-	DeliveryTime time.Time
-	ChangesTime  time.Time
-	Subvention   float64
+	DeliveryTime            time.Time
+	ChangesTime             time.Time
+	Subvention              Money
+	SuggestionMinSupport    float64 `gorm:"default:0.01"`              // share of orders containing both dishes
+	SuggestionMinConfidence float64 `gorm:"default:0.2"`               // share of orders with the first dish that contain the second
+	PreorderDays            uint    `gorm:"default:7"`                 // orders can be placed for the days ahead (0 = only today)
+	BusinessDays            string  `gorm:"size:20;default:1,2,3,4,5"` // days of the week with delivery, "1,2,3,4,5" (0 = Sunday)
+	TaxRate                 float64 `gorm:"default:10"`                // VAT percentage, included in the prices
+	BusinessName            string  `gorm:"size:250"`                  // issuer of receipts and invoices
+	BusinessTaxID           string  `gorm:"size:20"`
+	BusinessAddress         string  `gorm:"size:500"`
 }
 
 type User struct {
@@ -33,44 +41,216 @@ type User struct {
 	PostalCode string `gorm:"size:10"`
 	Phone      string `gorm:"size:20"`
 	IsAdmin    bool
-	Orders     []Order // has many
+	CompanyID  uint64     `gorm:"index"`   // FK - employer, its employees pay with their meal wallet (0 = pay every order)
+	OfficeDays string     `gorm:"size:20"` // days of the week in the office, "1,2,3" (empty = every day)
+	Orders     []Order    // has many
+	Allergens  []Allergen `gorm:"many2many:user_allergens;"` // known allergies, excluded from recommendations
+}
+
+type Company struct {
+	BaseModel
+	Name  string `gorm:"uniqueIndex;size:250"`
+	TaxID string `gorm:"size:20"` // shown on the monthly invoices
+}
+
+// Meal wallet of a company employee - orders are charged to it and deducted from payroll
+type Wallet struct {
+	BaseModel
+	UserID      uint64 `gorm:"uniqueIndex"` // FK - Wallet belongs to User
+	Balance     Money  // negative while the meals are pending to be deducted from payroll
+	CreditLimit *Money // how negative the balance can be (nil = no limit, 0 = no credit)
+}
+
+const (
+	WalletCredit     = "credit"
+	WalletDebit      = "debit"
+	WalletReversal   = "reversal" // order cancelled or changed, the debit is given back
+	WalletPayroll    = "payroll"  // balance of the month deducted from payroll
+	WalletAdjustment = "adjustment"
+)
+
+type WalletTransaction struct {
+	BaseModel
+	UserID      uint64 `gorm:"index"` // FK - transaction belongs to User
+	OrderID     uint64 `gorm:"index"` // FK - Order charged or reversed (0 = none)
+	Type        string `gorm:"size:20"`
+	Amount      Money  // positive credits, negative debits
+	Balance     Money  // balance after the transaction
+	Description string `gorm:"size:250"`
+	CreatedBy   uint64 // FK - User who made the transaction (0 = automatic)
+}
+
+const (
+	SubventionFixed      = "fixed"
+	SubventionPercentage = "percentage"
+)
+
+// The first rule that applies to an order, by priority, gives its subvention.
+// Without rules, the first order of each day gets Configuration.Subvention.
+type SubventionRule struct {
+	BaseModel
+	Name       string  `gorm:"size:250"`
+	Priority   uint    `gorm:"default:0"` // lower first
+	Disabled   bool    // kept for the orders that used it, but not applied anymore
+	Type       string  `gorm:"size:20;default:fixed"`
+	Amount     Money   // fixed rules: subvention - percentage rules: maximum (0 = no maximum)
+	Percentage float64 // percentage rules: share of the order cost, 0 to 100
+	Weekdays   string  `gorm:"size:20"` // days of the delivery, "1,2,3,4,5" (empty = every day)
+	CompanyID  uint64  // FK - only employees of the Company (0 = everyone)
+	InOffice   bool    // only the office days of the employee
+	MonthlyCap Money   // maximum subvention of an employee in a month (0 = no cap)
 }
 
 type Category struct {
 	BaseModel
-	Name string `gorm:"uniqueIndex;size:250"`
+	Name     string     `gorm:"uniqueIndex;size:250"`
+	ParentID uint64     `gorm:"index;default:0"` // FK - parent Category (0 = top level section)
+	Position uint       `gorm:"default:0"`       // display order among the sibling categories
+	Children []Category `gorm:"-"`               // filled by the category tree, not reflected in model
 }
 
 type Ingredient struct {
 	BaseModel
-	Name string `gorm:"uniqueIndex;size:250"`
+	Name      string     `gorm:"uniqueIndex;size:250"`
+	Allergens []Allergen `gorm:"many2many:ingredient_allergens;"` // allergens the ingredient contains
+	Traces    []Allergen `gorm:"many2many:ingredient_traces;"`    // allergens the ingredient may contain traces of
 }
 
 type Allergen struct {
 	BaseModel
-	Name string `gorm:"uniqueIndex;size:250"`
+	Name      string `gorm:"uniqueIndex;size:250"`
+	Regulated bool   `gorm:"default:false"` // one of the 14 allergens regulated by the EU (Regulation 1169/2011)
+}
+
+type SchemaMigration struct {
+	Name      string `gorm:"primaryKey;size:250"`
+	AppliedAt time.Time
 }
 
+// Responses of mutating requests, to answer retries with the same Idempotency-Key header
+type IdempotencyKey struct {
+	ID          uint64    `gorm:"primaryKey"`
+	UserID      uint64    `gorm:"uniqueIndex:ix_idempotency_key,priority:1"` // FK - key belongs to User
+	Key         string    `gorm:"size:250;uniqueIndex:ix_idempotency_key,priority:2"`
+	RequestHash string    `gorm:"size:64"` // sha256 of method, path and body
+	StatusCode  int       // 0 while the first request is still running
+	Response    string    `gorm:"type:text"`
+	CreatedAt   time.Time `gorm:"index"`
+}
+
+type Translation struct {
+	BaseModel
+	Entity   string `gorm:"size:50;uniqueIndex:ix_translation,priority:1"` // dishes, categories, ingredients, allergens
+	EntityID uint64 `gorm:"uniqueIndex:ix_translation,priority:2"`         // FK - ID of the translated object
+	Field    string `gorm:"size:50;uniqueIndex:ix_translation,priority:3"` // name, description
+	Language string `gorm:"size:10;uniqueIndex:ix_translation,priority:4"`
+	Value    string `gorm:"size:2000"`
+}
+
+const (
+	PromotionPrice      = "price"      // the dish is sold at Cost
+	PromotionPercentage = "percentage" // Percentage off the dish price
+	PromotionAmount     = "amount"     // Amount off the dish price
+	PromotionMultiBuy   = "multibuy"   // for every Buy units in the order only Pay units are paid, the cheapest are free
+)
+
 type Promotion struct {
 	BaseModel
-	DishID    uint64 // FK - Promotion belongs to Dish
-	Dish      Dish   // for preload joins (not reflected in model)
-	StartTime time.Time
-	EndTime   time.Time
-	Cost      float64 `gorm:"scale:2"`
+	Name       string   `gorm:"size:250"`
+	Type       string   `gorm:"size:20;default:price"`
+	DishID     uint64   `gorm:"index"`       // FK - Promotion belongs to Dish (0 = category promotion)
+	Dish       Dish     `gorm:"-:migration"` // for preload joins (not reflected in model)
+	CategoryID uint64   `gorm:"index"`       // FK - Promotion applies to the dishes of a Category and its subcategories (0 = dish promotion)
+	Category   Category `gorm:"-:migration"` // for preload joins (not reflected in model)
+	StartTime  time.Time
+	EndTime    time.Time
+	DailyStart string  `gorm:"size:5"`  // happy hour "15:04" in the day of the delivery (empty = all day)
+	DailyEnd   string  `gorm:"size:5"`  // end of the happy hour, excluded - may be before DailyStart to cross midnight
+	Weekdays   string  `gorm:"size:20"` // days of the week, "0,6" (0 = Sunday, empty = every day)
+	Cost       Money   // price promotions
+	Percentage float64 // percentage promotions, 0 to 100
+	Amount     Money   // amount promotions
+	Buy        uint    // multi-buy promotions: "3 for 2" is Buy 3, Pay 2
+	Pay        uint
 }
 
 type Dish struct {
 	BaseModel
-	Name        string       `gorm:"unique;size:250"`
-	Description string       `gorm:"size:2000"`
-	Categories  []Category   `gorm:"many2many:dish_categories;"`
-	Ingredients []Ingredient `gorm:"many2many:dish_ingredients;"`
-	Allergens   []Allergen   `gorm:"many2many:dish_allergens;"`
-	Cost        float64      `gorm:"scale:2"`
-	Promotions  []Promotion  // has many
-	Likes       uint64       `gorm:"default:0"`
-	Dislikes    uint64       `gorm:"default:0"`
+	Name           string       `gorm:"uniqueIndex:ix_dish_name,where:deleted_at IS NULL;size:250"` // deleted dishes don't keep their name
+	Description    string       `gorm:"size:2000"`
+	Categories     []Category   `gorm:"many2many:dish_categories;"`
+	Ingredients    []Ingredient `gorm:"many2many:dish_ingredients;"`
+	Allergens      []Allergen   `gorm:"many2many:dish_allergens;"`       // calculated: ingredient allergens and extra allergens
+	ExtraAllergens []Allergen   `gorm:"many2many:dish_extra_allergens;"` // added by hand, not contained by the ingredients
+	Traces         []Allergen   `gorm:"many2many:dish_traces;"`          // calculated: ingredient traces not in Allergens
+	Cost           Money
+	Promotions     []Promotion     `gorm:"-:migration"` // has many - category promotions have no dish, no FK
+	ModifierGroups []ModifierGroup // has many
+	Likes          uint64          `gorm:"default:0"`
+	Dislikes       uint64          `gorm:"default:0"`
+	RatingAverage  float64         `gorm:"default:0"` // visible reviews only
+	RatingCount    uint64          `gorm:"default:0"`
+	Ratings1       uint64          `gorm:"default:0"` // distribution of ratings
+	Ratings2       uint64          `gorm:"default:0"`
+	Ratings3       uint64          `gorm:"default:0"`
+	Ratings4       uint64          `gorm:"default:0"`
+	Ratings5       uint64          `gorm:"default:0"`
+	Archived       bool            `gorm:"index;default:false"` // archived dishes cannot be ordered, but keep their history
+	Stock          *uint           // portions left, taken by orders and given back when they are cancelled (nil = unlimited)
+}
+
+type ModifierGroup struct {
+	BaseModel
+	DishID    uint64 // FK - group belongs to Dish
+	Name      string `gorm:"size:250"` // size, sauces, remove ingredients...
+	Multiple  bool   // several modifiers of the group can be chosen (false = single choice)
+	Required  bool   // at least one modifier of the group must be chosen
+	Position  uint
+	Modifiers []Modifier // has many
+}
+
+type Modifier struct {
+	BaseModel
+	ModifierGroupID uint64 // FK - modifier belongs to ModifierGroup
+	Name            string `gorm:"size:250"` // half portion, extra sauce, without onion...
+	CostDelta       Money  // added to the dish cost, negative for discounts
+	IngredientID    uint64 // FK - Ingredient removed from the dish (0 = not a removal)
+}
+
+type DishPrice struct {
+	BaseModel
+	DishID        uint64 `gorm:"index:ix_dish_price,priority:1"` // FK - price belongs to Dish
+	Cost          Money
+	EffectiveFrom time.Time `gorm:"index:ix_dish_price,priority:2"`
+	UserID        uint64    // FK - administrator who made the change
+	User          User      // For preload joins, not reflected in model
+}
+
+type DishReview struct {
+	BaseModel
+	DishID    uint64 `gorm:"uniqueIndex:ix_user_review;"` // FK
+	UserID    uint64 `gorm:"uniqueIndex:ix_user_review;"` // FK
+	User      User   // For preload joins, not reflected in model
+	Rating    uint   // 1 to 5
+	Comment   string `gorm:"size:2000"`
+	Moderated bool   `gorm:"index"` // reviewed by an administrator, edits need a new moderation
+	Hidden    bool   // hidden by an administrator
+}
+
+type DishRecommendation struct {
+	BaseModel
+	UserID uint64  `gorm:"uniqueIndex:ix_user_recommendation;"` // FK
+	DishID uint64  `gorm:"uniqueIndex:ix_user_recommendation;"` // FK
+	Score  float64 // higher is better
+}
+
+type DishAssociation struct {
+	BaseModel
+	DishID      uint64  `gorm:"uniqueIndex:ix_dish_association;"` // FK - dish in the order
+	OtherDishID uint64  `gorm:"uniqueIndex:ix_dish_association;"` // FK - dish frequently ordered with it
+	Support     float64 // share of orders containing both dishes
+	Confidence  float64 // share of orders with DishID that contain OtherDishID
+	Lift        float64 // confidence compared to ordering OtherDishID at random
 }
 
 type DishLike struct {
@@ -85,30 +265,250 @@ type DishDislike struct {
 	UserID uint64 `gorm:"uniqueIndex:ix_user_dislike;"` // FK
 }
 
+type SetMenu struct {
+	BaseModel
+	Name        string          `gorm:"uniqueIndex;size:250"`
+	Description string          `gorm:"size:2000"`
+	Cost        Money           // fixed price for the whole menu
+	Courses     []SetMenuCourse // has many
+}
+
+type SetMenuCourse struct {
+	BaseModel
+	SetMenuID  uint64 // FK - course belongs to SetMenu
+	Name       string `gorm:"size:250"` // starter, main, dessert, drink...
+	Position   uint
+	CategoryID uint64 // FK - dishes of this Category can be chosen (0 = no restriction)
+	Dishes     []Dish `gorm:"many2many:set_menu_course_dishes;"` // only these dishes can be chosen (empty = no restriction)
+}
+
 type OrderLine struct {
 	BaseModel
-	OrderID  uint64  // FK - line belongs to Order
-	DishID   uint64  // FK - line has 1 Dish
-	Name     string  `gorm:"size:250"` // don't use dish references - attributes will change
-	CostUnit float64 `gorm:"scale:2"`  // don't use dish references - attributes will change
-	Quantity uint
+	OrderID           uint64 // FK - line belongs to Order
+	DishID            uint64 // FK - line has 1 Dish (0 for set menus)
+	SetMenuID         uint64 // FK - line has 1 SetMenu (0 for dishes)
+	Name              string `gorm:"size:250"` // don't use dish references - attributes will change
+	CostUnit          Money  // don't use dish references - attributes will change
+	Quantity          uint
+	Promotion         string              `gorm:"size:250"` // promotion in CostUnit (empty = none)
+	PromotionDiscount Money               // taken off the price of every unit by the promotion
+	Choices           []OrderLineChoice   // has many - dish chosen for every set menu course
+	Modifiers         []OrderLineModifier // has many - modifiers chosen for the dish
+}
+
+type OrderLineModifier struct {
+	BaseModel
+	OrderLineID uint64 // FK - modifier belongs to OrderLine
+	ModifierID  uint64 // FK - modifier has 1 Modifier
+	Name        string `gorm:"size:250"` // don't use modifier references - attributes will change
+	CostDelta   Money  // don't use modifier references - attributes will change
+}
+
+type OrderLineChoice struct {
+	BaseModel
+	OrderLineID     uint64 // FK - choice belongs to OrderLine
+	SetMenuCourseID uint64 // FK - choice fills 1 SetMenuCourse
+	DishID          uint64 // FK - choice has 1 Dish
+	CourseName      string `gorm:"size:250"` // don't use course references - attributes will change
+	Name            string `gorm:"size:250"` // don't use dish references - attributes will change
+}
+
+const (
+	CouponAmount     = "amount"     // Amount off the order
+	CouponPercentage = "percentage" // Percentage off the order
+)
+
+type Coupon struct {
+	BaseModel
+	Code        string  `gorm:"uniqueIndex:ix_coupon_code,where:deleted_at IS NULL;size:50"` // stored in upper case
+	Description string  `gorm:"size:2000"`
+	Type        string  `gorm:"size:20;default:amount"`
+	Amount      Money   // amount coupons
+	Percentage  float64 // percentage coupons, 0 to 100
+	MinOrder    Money   // minimum order cost, after promotions (0 = no minimum)
+	MaxUses     uint    // redemptions of all the users (0 = unlimited)
+	MaxUsesUser uint    // redemptions of every user (0 = unlimited)
+	Uses        uint    `gorm:"default:0"` // current redemptions
+	StartTime   time.Time
+	EndTime     time.Time
+	Dishes      []Dish     `gorm:"many2many:coupon_dishes;"`     // discount only these dishes (empty = no restriction)
+	Categories  []Category `gorm:"many2many:coupon_categories;"` // discount only the dishes of these categories and subcategories (empty = no restriction)
+}
+
+type CouponRedemption struct {
+	BaseModel
+	CouponID uint64 `gorm:"index:ix_coupon_user,priority:1"` // FK - redemption of 1 Coupon
+	UserID   uint64 `gorm:"index:ix_coupon_user,priority:2"` // FK - redeemed by User
+	OrderID  uint64 `gorm:"uniqueIndex"`                     // FK - 1 coupon per Order
+}
+
+type OrderDiscount struct {
+	BaseModel
+	OrderID     uint64 // FK - discount belongs to Order
+	PromotionID uint64 // FK - discount has 1 Promotion
+	Name        string `gorm:"size:250"` // don't use promotion references - attributes will change
+	Amount      Money
+}
+
+type Subscription struct {
+	BaseModel
+	UserID       uint64             `gorm:"index"` // FK - Subscription belongs to User
+	Name         string             `gorm:"size:250"`
+	Weekdays     string             `gorm:"size:20"` // days of the week to order, "1,2,3,4,5" (0 = Sunday)
+	Paused       bool               // paused subscriptions don't create orders
+	Materialized time.Time          `gorm:"type:date"` // last day ordered, or notified that it couldn't be ordered
+	Lines        []SubscriptionLine // has many
+	Skips        []SubscriptionSkip // has many
+}
+
+type SubscriptionLine struct {
+	BaseModel
+	SubscriptionID uint64 // FK - line belongs to Subscription
+	DishID         uint64 // FK - line has 1 Dish (0 for set menus)
+	SetMenuID      uint64 // FK - line has 1 SetMenu (0 for dishes)
+	Quantity       uint
+	Choices        []SubscriptionLineChoice   // has many - dish chosen for every set menu course
+	Modifiers      []SubscriptionLineModifier // has many - modifiers chosen for the dish
+}
+
+type SubscriptionLineModifier struct {
+	BaseModel
+	SubscriptionLineID uint64 // FK - modifier belongs to SubscriptionLine
+	ModifierID         uint64 // FK - modifier has 1 Modifier
+}
+
+type SubscriptionLineChoice struct {
+	BaseModel
+	SubscriptionLineID uint64 // FK - choice belongs to SubscriptionLine
+	SetMenuCourseID    uint64 // FK - choice fills 1 SetMenuCourse
+	DishID             uint64 // FK - choice has 1 Dish
+}
+
+type SubscriptionSkip struct {
+	BaseModel
+	SubscriptionID uint64    `gorm:"uniqueIndex:ix_subscription_skip,priority:1"`           // FK - skip belongs to Subscription
+	Day            time.Time `gorm:"type:date;uniqueIndex:ix_subscription_skip,priority:2"` // no order this day
+}
+
+type Notification struct {
+	BaseModel
+	UserID  uint64 `gorm:"index"` // FK - Notification belongs to User
+	Message string `gorm:"size:2000"`
+	Read    bool
+}
+
+// Payment method of the orders of company employees
+const PaymentMethodWallet = "wallet"
+
+const (
+	PaymentUnpaid     = "unpaid"
+	PaymentAuthorized = "authorized"
+	PaymentCaptured   = "captured"
+	PaymentRefunded   = "refunded"
+	PaymentFailed     = "failed"
+)
+
+// Card refunds are recorded with the order changes, and given back by the provider after they are committed
+type PaymentRefund struct {
+	BaseModel
+	OrderID   uint64 `gorm:"index"` // FK - refund belongs to Order
+	PaymentID string `gorm:"size:250"`
+	Amount    Money
+	Status    string `gorm:"size:20;index;default:pending"`
+	Attempts  uint
+}
+
+const (
+	RefundPending = "pending"
+	RefundDone    = "done"
+	RefundFailed  = "failed" // the provider refused it too many times, it has to be given back by hand
+)
+
+const (
+	OrderPlaced      = "placed"
+	OrderConfirmed   = "confirmed"
+	OrderPreparation = "preparation"
+	OrderReady       = "ready"
+	OrderDelivering  = "delivering"
+	OrderDelivered   = "delivered"
+	OrderCancelled   = "cancelled"
+)
+
+// Why an order was cancelled
+const (
+	CancelCustomer   = "customer"     // by its owner, before the kitchen cutoff
+	CancelOutOfStock = "out_of_stock" // the kitchen ran out of a dish
+	CancelKitchen    = "kitchen"      // the kitchen cannot prepare or deliver it
+	CancelPayment    = "payment"      // the payment failed
+	CancelOther      = "other"
+)
+
+type OrderStatusChange struct {
+	BaseModel
+	OrderID    uint64 `gorm:"index"`   // FK - change belongs to Order
+	FromStatus string `gorm:"size:20"` // empty for the creation of the order
+	Status     string `gorm:"size:20"`
+	UserID     uint64 // FK - user who made the change
+	User       User   // For preload joins, not reflected in model
+	Comment    string `gorm:"size:2000"`
 }
 
 type Order struct {
 	BaseModel
-	OrderLines    []OrderLine
-	UserID        uint64  // FK - Order belongs to User
-	User          User    // For preload joins, not reflected in model
-	CostTotal     float64 `gorm:"scale:2"`
-	CostToPay     float64 `gorm:"scale:2"` // cost to pay after subvention
-	Subvention    float64 `gorm:"scale:2"` // subvention applied
-	Delivery      time.Time
-	Address1      string `gorm:"size:250"`
-	Address2      string `gorm:"size:250"`
-	Address3      string `gorm:"size:250"`
-	City          string `gorm:"size:250"`
-	PostalCode    string `gorm:"size:10"`
-	Phone         string `gorm:"size:20"`
-	PaymentMethod string `gorm:"size:250"`
-	PaymentSecret string `gorm:"size:250"`
+	OrderLines       []OrderLine
+	UserID           uint64              // FK - Order belongs to User
+	User             User                // For preload joins, not reflected in model
+	SubscriptionID   uint64              `gorm:"index"` // FK - Subscription that created the order (0 = ordered by hand)
+	Status           string              `gorm:"size:20;index;default:placed"`
+	StatusHistory    []OrderStatusChange // has many
+	CancelledBy      uint64              // FK - User who cancelled the order (0 = not cancelled)
+	CancelReason     string              `gorm:"size:20"`
+	CostTotal        Money
+	Discount         Money           // order promotions (multi-buy), already taken from CostTotal
+	Discounts        []OrderDiscount // has many
+	CouponID         uint64          // FK - Coupon applied to the order (0 = none)
+	CouponCode       string          `gorm:"size:50"`
+	CouponDiscount   Money           // coupon discount, already taken from CostTotal
+	CostToPay        Money           // cost to pay after subvention
+	Subvention       Money           // subvention applied
+	SubventionRule   string          `gorm:"size:250"` // name of the rule that gave the subvention, for auditing
+	SubventionRuleID uint64          // FK - SubventionRule applied (0 = none, or the default subvention)
+	Delivery         time.Time
+	Address1         string `gorm:"size:250"`
+	Address2         string `gorm:"size:250"`
+	Address3         string `gorm:"size:250"`
+	City             string `gorm:"size:250"`
+	PostalCode       string `gorm:"size:10"`
+	Phone            string `gorm:"size:20"`
+	PaymentMethod    string `gorm:"size:250"`                     // provider that took the payment
+	PaymentSecret    string `gorm:"size:250"`                     // client secret, for the frontend to confirm the payment with the provider
+	PaymentID        string `gorm:"size:250;index"`               // payment at the provider
+	PaymentStatus    string `gorm:"size:20;index;default:unpaid"` // authorized at checkout, captured at the kitchen cutoff
+	PaymentAmount    Money  // authorized at checkout
+	PaymentRefunded  Money  // given back of PaymentAmount
+}
+
+const (
+	InvoiceReceipt = "receipt" // one per delivered order
+	InvoiceMonthly = "invoice" // one per user or company and month
+)
+
+// Receipts and monthly invoices - numbered without gaps, kept as issued
+type Invoice struct {
+	BaseModel
+	Type      string    `gorm:"size:20;uniqueIndex:ix_invoice_subject,priority:1"`
+	OrderID   uint64    `gorm:"uniqueIndex:ix_invoice_subject,priority:2"`       // FK - receipts: Order (0 = monthly invoice)
+	UserID    uint64    `gorm:"uniqueIndex:ix_invoice_subject,priority:3;index"` // FK - customer (0 = company invoice)
+	CompanyID uint64    `gorm:"uniqueIndex:ix_invoice_subject,priority:4;index"` // FK - company invoices: customer (0 = user)
+	Month     time.Time `gorm:"uniqueIndex:ix_invoice_subject,priority:5"`       // monthly invoices: first day of the month
+	Number    string    `gorm:"size:20;uniqueIndex"`                             // "REC-2023-000001", series-year-sequence
+	Total     Money
+	Content   string `gorm:"type:text"` // InvoiceContent as JSON - the PDF is generated from it, not from the orders
+}
+
+// Last number of a series in a year - locked while issuing, so numbers have no gaps
+type InvoiceCounter struct {
+	Series string `gorm:"primaryKey;size:5"`
+	Year   int    `gorm:"primaryKey;autoIncrement:false"`
+	Last   uint64
 }
@@ -1,11 +1,29 @@
 package models
 
+type PaginationCompanies struct {
+	Companies []Company `json:"companies"`
+	Page      uint64    `json:"page"`
+	Limit     uint64    `json:"limit"`
+}
+
+type PaginationCoupons struct {
+	Coupons []Coupon `json:"coupons"`
+	Page    uint64   `json:"page"`
+	Limit   uint64   `json:"limit"`
+}
+
 type PaginationDishes struct {
 	Dishes []Dish `json:"dishes"`
 	Page   uint64 `json:"page"`
 	Limit  uint64 `json:"limit"`
 }
 
+type PaginationNotifications struct {
+	Notifications []Notification `json:"notifications"`
+	Page          uint64         `json:"page"`
+	Limit         uint64         `json:"limit"`
+}
+
 type PaginationOrders struct {
 	Orders []Order `json:"orders"`
 	Page   uint64  `json:"page"`
@@ -18,8 +36,44 @@ type PaginationPromotion struct {
 	Limit      uint64      `json:"limit"`
 }
 
+type PaginationReviews struct {
+	Reviews []DishReview `json:"reviews"`
+	Page    uint64       `json:"page"`
+	Limit   uint64       `json:"limit"`
+}
+
+type PaginationSetMenus struct {
+	SetMenus []SetMenu `json:"setMenus"`
+	Page     uint64    `json:"page"`
+	Limit    uint64    `json:"limit"`
+}
+
+type PaginationSubscriptions struct {
+	Subscriptions []Subscription `json:"subscriptions"`
+	Page          uint64         `json:"page"`
+	Limit         uint64         `json:"limit"`
+}
+
+type PaginationSubventionRules struct {
+	SubventionRules []SubventionRule `json:"subventionRules"`
+	Page            uint64           `json:"page"`
+	Limit           uint64           `json:"limit"`
+}
+
 type PaginationUsers struct {
 	Users []User `json:"users"`
 	Page  uint64 `json:"page"`
 	Limit uint64 `json:"limit"`
 }
+
+type PaginationWalletTransactions struct {
+	Transactions []WalletTransaction `json:"transactions"`
+	Page         uint64              `json:"page"`
+	Limit        uint64              `json:"limit"`
+}
+
+type PaginationInvoices struct {
+	Invoices []Invoice `json:"invoices"`
+	Page     uint64    `json:"page"`
+	Limit    uint64    `json:"limit"`
+}
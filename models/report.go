@@ -0,0 +1,15 @@
+package models
+
+type Blocker struct {
+	Entity string `json:"entity"`
+	ID     uint64 `json:"id"`
+	Name   string `json:"name"`
+}
+
+type AllergenConsistency struct {
+	DishID      uint64   `json:"dishId"`
+	Name        string   `json:"name"`
+	Missing     []string `json:"missing"`     // contained by the ingredients or added by hand, not in the dish allergens
+	Unexplained []string `json:"unexplained"` // in the dish allergens, not contained by any ingredient nor added by hand
+	Redundant   []string `json:"redundant"`   // added by hand, already contained by an ingredient
+}
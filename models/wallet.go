@@ -0,0 +1,37 @@
+package models
+
+type WalletLimitRequest struct {
+	CreditLimit *Money `json:"creditLimit"` // null = no limit, 0 = no credit
+}
+
+type WalletTransactionRequest struct {
+	Type        string `json:"type"`   // credit or adjustment - debits are made by the orders
+	Amount      Money  `json:"amount"` // adjustments can be negative
+	Description string `json:"description"`
+}
+
+// Wallet movements of an employee in a month
+type WalletStatement struct {
+	UserID       uint64              `json:"userId"`
+	Email        string              `json:"email"`
+	Name         string              `json:"name"`
+	Surname      string              `json:"surname"`
+	Month        string              `json:"month"`
+	Opening      Money               `json:"opening"`
+	Credits      Money               `json:"credits"`
+	Debits       Money               `json:"debits"`      // positive
+	Reversals    Money               `json:"reversals"`   // debits given back
+	Settlements  Money               `json:"settlements"` // deducted from payroll
+	Adjustments  Money               `json:"adjustments"`
+	Closing      Money               `json:"closing"`
+	Payroll      Money               `json:"payroll"` // to deduct from payroll, the negative closing balance
+	Transactions []WalletTransaction `json:"transactions,omitempty"`
+}
+
+type CompanyStatement struct {
+	CompanyID uint64            `json:"companyId"`
+	Name      string            `json:"name"`
+	Month     string            `json:"month"`
+	Payroll   Money             `json:"payroll"` // all the employees
+	Employees []WalletStatement `json:"employees"`
+}
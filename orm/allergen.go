@@ -1,7 +1,9 @@
 package orm
 
 import (
+	"database/sql"
 	"errors"
+	"sort"
 	"tfm_backend/models"
 
 	"github.com/rs/zerolog/log"
@@ -22,6 +24,65 @@ func (d *Database) AllergenCreate(allergen models.Allergen) (models.Allergen, er
 	return allergen, nil
 }
 
+// Dishes whose allergens don't match the ones derived from their ingredients and extra allergens
+func (d *Database) AllergenConsistency() ([]models.AllergenConsistency, error) {
+	var report []models.AllergenConsistency
+
+	var dishes []models.Dish
+	err := d.db.Preload("Allergens").Preload("ExtraAllergens").Preload("Ingredients.Allergens").Order("name").Find(&dishes).Error
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to read dishes for allergen consistency")
+		return report, err
+	}
+
+	for _, dish := range dishes {
+		contained := make(map[string]bool)
+		for _, ingredient := range dish.Ingredients {
+			for _, allergen := range ingredient.Allergens {
+				contained[allergen.Name] = true
+			}
+		}
+		derived := make(map[string]bool)
+		for name := range contained {
+			derived[name] = true
+		}
+		for _, allergen := range dish.ExtraAllergens {
+			derived[allergen.Name] = true
+		}
+		calculated := make(map[string]bool)
+		for _, allergen := range dish.Allergens {
+			calculated[allergen.Name] = true
+		}
+
+		item := models.AllergenConsistency{DishID: dish.ID, Name: dish.Name}
+		for name := range derived {
+			if !calculated[name] {
+				item.Missing = append(item.Missing, name)
+			}
+		}
+		for name := range calculated {
+			if !derived[name] {
+				item.Unexplained = append(item.Unexplained, name)
+			}
+		}
+		// extra allergens repeating an ingredient allergen stay when the ingredient changes
+		for _, allergen := range dish.ExtraAllergens {
+			if contained[allergen.Name] {
+				item.Redundant = append(item.Redundant, allergen.Name)
+			}
+		}
+
+		if len(item.Missing) > 0 || len(item.Unexplained) > 0 || len(item.Redundant) > 0 {
+			sort.Strings(item.Missing)
+			sort.Strings(item.Unexplained)
+			sort.Strings(item.Redundant)
+			report = append(report, item)
+		}
+	}
+
+	return report, nil
+}
+
 func (d *Database) AllergenDetails(allergenId uint64) (models.Allergen, error) {
 	var allergen models.Allergen
 	err := d.db.First(&allergen, allergenId).Error
@@ -47,6 +108,19 @@ func (d *Database) AllergenDelete(allergenId uint64) error {
 		return errors.New(`Dishes associated to this Allergen exist - Remove the Dish association first`)
 	}
 
+	// Is the allergen associated to any ingredient?
+	res = d.db.Raw(`SELECT ingredient_id FROM ingredient_allergens WHERE allergen_id = ?
+		UNION SELECT ingredient_id FROM ingredient_traces WHERE allergen_id = ? LIMIT 1`, allergenId, allergenId).Scan(&value)
+	if res.Error != nil {
+		log.Error().Err(res.Error).Uint64("allergenId", allergenId).Msg("Failed to find Ingredient with Allergen")
+		return res.Error
+	}
+
+	if res.RowsAffected > 0 {
+		log.Warn().Uint64("allergenId", allergenId).Msg("Ingredients with Allergen exist - we cannot remove it")
+		return errors.New(`Ingredients associated to this Allergen exist - Remove the Ingredient association first`)
+	}
+
 	err := d.db.Unscoped().Where("id = ?", allergenId).Delete(&models.Allergen{}).Error
 	if err != nil {
 		log.Error().Err(err).Uint64("allergenId", allergenId).Msg("Failed to delete Allergen")
@@ -63,8 +137,8 @@ func (d *Database) AllergenDishes(allergenId uint64, limit uint64, offset uint64
 	err = d.db.Preload("Allergens", func(db *gorm.DB) *gorm.DB {
 		return db.Order("allergens.name")
 	}).Preload("Categories", func(db *gorm.DB) *gorm.DB {
-		return db.Order("categories.name")
-	}).Joins("RIGHT JOIN dish_categories ON dish_categories.dish_id = dishes.id").
+		return db.Order("categories.position, categories.name")
+	}).Scopes(dishesActive).Joins("RIGHT JOIN dish_categories ON dish_categories.dish_id = dishes.id").
 		Where(`dish_categories.allergen_id = ?`, allergenId).
 		Order("name").Limit(int(limit)).Offset(int(offset)).Find(&dishes).Error
 	if err != nil {
@@ -108,3 +182,35 @@ func (d *Database) AllergenModify(allergen models.Allergen) (models.Allergen, er
 
 	return allergen, nil
 }
+
+// Recalculates dish allergens (ingredient allergens and extra allergens) and traces (ingredient traces not contained)
+func dishUpdateAllergens(tx *gorm.DB, dishIds []uint64) error {
+	if len(dishIds) == 0 {
+		return nil
+	}
+
+	statements := []string{
+		`DELETE FROM dish_allergens WHERE dish_id IN @dishes`,
+		`INSERT INTO dish_allergens (dish_id, allergen_id)
+			SELECT di.dish_id, ia.allergen_id FROM dish_ingredients di
+				JOIN ingredient_allergens ia ON ia.ingredient_id = di.ingredient_id WHERE di.dish_id IN @dishes
+			UNION
+			SELECT dish_id, allergen_id FROM dish_extra_allergens WHERE dish_id IN @dishes`,
+		`DELETE FROM dish_traces WHERE dish_id IN @dishes`,
+		`INSERT INTO dish_traces (dish_id, allergen_id)
+			SELECT DISTINCT di.dish_id, it.allergen_id FROM dish_ingredients di
+				JOIN ingredient_traces it ON it.ingredient_id = di.ingredient_id
+			WHERE di.dish_id IN @dishes AND NOT EXISTS (
+				SELECT 1 FROM dish_allergens da WHERE da.dish_id = di.dish_id AND da.allergen_id = it.allergen_id)`,
+	}
+
+	for _, statement := range statements {
+		err := tx.Exec(statement, sql.Named("dishes", dishIds)).Error
+		if err != nil {
+			log.Error().Err(err).Interface("dishIds", dishIds).Msg("Failed to update dish allergens")
+			return err
+		}
+	}
+
+	return nil
+}
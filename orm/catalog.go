@@ -0,0 +1,575 @@
+package orm
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"tfm_backend/models"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+func (d *Database) CatalogExport() (models.Catalog, error) {
+	var err error
+	var catalog models.Catalog
+
+	var categories []models.Category
+	err = d.db.Order("position, name").Find(&categories).Error
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to export categories")
+		return catalog, err
+	}
+
+	var promotions []models.Promotion
+	err = d.db.Where("category_id > 0").Order("start_time").Find(&promotions).Error
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to export category promotions")
+		return catalog, err
+	}
+	catalog.Categories = catalogCategories(categories, promotions)
+
+	var ingredients []models.Ingredient
+	err = d.db.Preload("Allergens").Preload("Traces").Order("name").Find(&ingredients).Error
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to export ingredients")
+		return catalog, err
+	}
+	for _, ingredient := range ingredients {
+		catalog.Ingredients = append(catalog.Ingredients, catalogIngredient(ingredient))
+	}
+
+	err = d.db.Model(&models.Allergen{}).Order("name").Pluck("name", &catalog.Allergens).Error
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to export allergens")
+		return catalog, err
+	}
+
+	var dishes []models.Dish
+	err = d.db.Preload("Categories").Preload("Ingredients").Preload("ExtraAllergens").
+		Preload("Promotions", func(db *gorm.DB) *gorm.DB {
+			return db.Order("promotions.start_time")
+		}).Order("name").Find(&dishes).Error
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to export dishes")
+		return catalog, err
+	}
+
+	for _, dish := range dishes {
+		catalog.Dishes = append(catalog.Dishes, catalogDish(dish))
+	}
+
+	return catalog, nil
+}
+
+// Creates or updates every object of the catalog in a single transaction, matching them by name.
+// Objects missing from the catalog are not removed. With dryRun the changes are reported and rolled back.
+func (d *Database) CatalogImport(catalog models.Catalog, dryRun bool, userId uint64) ([]models.CatalogChange, error) {
+	var err error
+	var changes []models.CatalogChange
+
+	tx := d.db.Begin()
+	defer tx.Rollback()
+
+	categories := make(map[string]models.Category)
+	ingredients := make(map[string]models.Ingredient)
+	allergens := make(map[string]models.Allergen)
+	created := make(map[string]bool)
+
+	// standalone objects and the ones referenced by dishes
+	names := catalogNames(catalog)
+	for _, name := range names["categories"] {
+		var category models.Category
+		err = tx.Where("name = ?", name).First(&category).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			category = models.Category{Name: name}
+			err = tx.Create(&category).Error
+			changes = append(changes, models.CatalogChange{Entity: "category", Name: name, Action: "create"})
+			created["category:"+name] = true
+		}
+		if err != nil {
+			log.Error().Err(err).Str("name", name).Msg("Failed to import category")
+			return nil, err
+		}
+		categories[name] = category
+	}
+
+	for _, name := range names["ingredients"] {
+		var ingredient models.Ingredient
+		err = tx.Where("name = ?", name).First(&ingredient).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ingredient = models.Ingredient{Name: name}
+			err = tx.Create(&ingredient).Error
+			changes = append(changes, models.CatalogChange{Entity: "ingredient", Name: name, Action: "create"})
+			created["ingredient:"+name] = true
+		}
+		if err != nil {
+			log.Error().Err(err).Str("name", name).Msg("Failed to import ingredient")
+			return nil, err
+		}
+		ingredients[name] = ingredient
+	}
+
+	for _, name := range names["allergens"] {
+		var allergen models.Allergen
+		err = tx.Where("name = ?", name).First(&allergen).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			allergen = models.Allergen{Name: name}
+			err = tx.Create(&allergen).Error
+			changes = append(changes, models.CatalogChange{Entity: "allergen", Name: name, Action: "create"})
+		}
+		if err != nil {
+			log.Error().Err(err).Str("name", name).Msg("Failed to import allergen")
+			return nil, err
+		}
+		allergens[name] = allergen
+	}
+
+	// category tree and category promotions - parents come first in exported catalogs
+	for _, item := range catalog.Categories {
+		category := categories[item.Name]
+
+		var parent models.Category
+		if category.ParentID > 0 {
+			err = tx.Select("name").First(&parent, category.ParentID).Error
+			if err != nil {
+				log.Error().Err(err).Uint64("parentId", category.ParentID).Msg("Failed to read parent category for import")
+				return nil, err
+			}
+		}
+
+		var promotions []models.Promotion
+		err = tx.Where("category_id = ?", category.ID).Find(&promotions).Error
+		if err != nil {
+			log.Error().Err(err).Str("name", item.Name).Msg("Failed to read category promotions for import")
+			return nil, err
+		}
+
+		current := models.CatalogCategory{Name: category.Name, Parent: &parent.Name, Position: &category.Position}
+		for _, promotion := range promotions {
+			current.Promotions = append(current.Promotions, catalogPromotion(promotion))
+		}
+		categoryChanges := catalogCategoryChanges(current, item)
+		if len(categoryChanges) == 0 {
+			continue
+		}
+
+		values := make(map[string]interface{})
+		if item.Parent != nil {
+			parentId := categories[*item.Parent].ID
+			err = d.categoryCheckParent(tx, category.ID, parentId)
+			if err != nil {
+				return nil, err
+			}
+			values["parent_id"] = parentId
+		}
+		if item.Position != nil {
+			values["position"] = *item.Position
+		}
+		if len(values) > 0 {
+			err = tx.Model(&category).Updates(values).Error
+			if err != nil {
+				log.Error().Err(err).Str("name", item.Name).Msg("Failed to import category")
+				return nil, err
+			}
+		}
+
+		err = catalogPromotionsImport(tx, "category "+category.Name, 0, category.ID, promotions, item.Promotions)
+		if err != nil {
+			log.Error().Err(err).Str("name", item.Name).Msg("Failed to import category promotions")
+			return nil, err
+		}
+
+		if !created["category:"+item.Name] {
+			changes = append(changes, models.CatalogChange{Entity: "category", Name: item.Name, Action: "update", Changes: categoryChanges})
+		}
+	}
+
+	// allergens of the ingredients, before the dishes derive theirs
+	for _, item := range catalog.Ingredients {
+		ingredient := ingredients[item.Name]
+		err = tx.Preload("Allergens").Preload("Traces").First(&ingredient, ingredient.ID).Error
+		if err != nil {
+			log.Error().Err(err).Str("name", item.Name).Msg("Failed to read ingredient for import")
+			return nil, err
+		}
+
+		ingredientChanges := catalogIngredientChanges(catalogIngredient(ingredient), item)
+		if len(ingredientChanges) == 0 {
+			continue
+		}
+
+		for association, list := range map[string][]string{"Allergens": item.Allergens, "Traces": item.Traces} {
+			if list == nil {
+				continue
+			}
+			values := make([]models.Allergen, 0, len(list))
+			for _, name := range list {
+				values = append(values, allergens[name])
+			}
+			err = tx.Model(&ingredient).Association(association).Replace(values)
+			if err != nil {
+				log.Error().Err(err).Str("name", item.Name).Str("association", association).Msg("Failed to import ingredient association")
+				return nil, err
+			}
+		}
+
+		var dishIds []uint64
+		err = tx.Raw(`SELECT dish_id FROM dish_ingredients WHERE ingredient_id = ?`, ingredient.ID).Scan(&dishIds).Error
+		if err != nil {
+			log.Error().Err(err).Uint64("ingredientId", ingredient.ID).Msg("Failed to find Dish with Ingredient")
+			return nil, err
+		}
+
+		err = dishUpdateAllergens(tx, dishIds)
+		if err != nil {
+			return nil, err
+		}
+
+		if !created["ingredient:"+item.Name] {
+			changes = append(changes, models.CatalogChange{Entity: "ingredient", Name: item.Name, Action: "update", Changes: ingredientChanges})
+		}
+	}
+
+	for _, item := range catalog.Dishes {
+		if len(strings.TrimSpace(item.Name)) == 0 {
+			return nil, validationErrorf("dishes must have a name")
+		}
+
+		var dish models.Dish
+		err = tx.Preload("Categories").Preload("Ingredients").Preload("ExtraAllergens").Preload("Promotions").
+			Where("name = ?", item.Name).First(&dish).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error().Err(err).Str("name", item.Name).Msg("Failed to read dish for import")
+			return nil, err
+		}
+
+		change := models.CatalogChange{Entity: "dish", Name: item.Name, Action: "update"}
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			change.Action = "create"
+		} else {
+			change.Changes = catalogDishChanges(catalogDish(dish), item)
+			if len(change.Changes) == 0 {
+				continue
+			}
+		}
+
+		dish.Name = item.Name
+		dish.Description = item.Description
+		dish.Cost = item.Cost
+		dish.Categories = nil
+		for _, name := range item.Categories {
+			dish.Categories = append(dish.Categories, categories[name])
+		}
+		dish.Ingredients = nil
+		for _, name := range item.Ingredients {
+			dish.Ingredients = append(dish.Ingredients, ingredients[name])
+		}
+		dish.ExtraAllergens = nil
+		for _, name := range item.Allergens {
+			dish.ExtraAllergens = append(dish.ExtraAllergens, allergens[name])
+		}
+
+		err = tx.Omit("Categories", "Ingredients", "Allergens", "ExtraAllergens", "Traces", "Promotions", "ModifierGroups").Save(&dish).Error
+		if err != nil {
+			log.Error().Err(err).Str("name", item.Name).Msg("Failed to import dish")
+			return nil, err
+		}
+
+		for association, values := range map[string]interface{}{"Categories": dish.Categories, "Ingredients": dish.Ingredients, "ExtraAllergens": dish.ExtraAllergens} {
+			err = tx.Model(&dish).Association(association).Replace(values)
+			if err != nil {
+				log.Error().Err(err).Str("name", item.Name).Str("association", association).Msg("Failed to import dish association")
+				return nil, err
+			}
+		}
+
+		err = dishUpdateAllergens(tx, []uint64{dish.ID})
+		if err != nil {
+			return nil, err
+		}
+
+		err = d.dishPriceRecord(tx, dish.ID, dish.Cost, userId)
+		if err != nil {
+			return nil, err
+		}
+
+		err = catalogPromotionsImport(tx, "dish "+dish.Name, dish.ID, 0, dish.Promotions, item.Promotions)
+		if err != nil {
+			log.Error().Err(err).Str("name", item.Name).Msg("Failed to import dish promotions")
+			return nil, err
+		}
+
+		changes = append(changes, change)
+	}
+
+	if dryRun {
+		return changes, nil
+	}
+
+	err = tx.Commit().Error
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to commit catalog import")
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// Categories with their parent and position, parents before their subcategories
+func catalogCategories(categories []models.Category, promotions []models.Promotion) []models.CatalogCategory {
+	names := make(map[uint64]string, len(categories))
+	for _, category := range categories {
+		names[category.ID] = category.Name
+	}
+
+	var items []models.CatalogCategory
+	var flatten func(nodes []models.Category)
+	flatten = func(nodes []models.Category) {
+		for _, node := range nodes {
+			parent, position := names[node.ParentID], node.Position
+			item := models.CatalogCategory{Name: node.Name, Parent: &parent, Position: &position}
+			for _, promotion := range promotions {
+				if promotion.CategoryID == node.ID {
+					item.Promotions = append(item.Promotions, catalogPromotion(promotion))
+				}
+			}
+			items = append(items, item)
+			flatten(node.Children)
+		}
+	}
+	flatten(CategoryTree(categories))
+
+	return items
+}
+
+func catalogIngredient(ingredient models.Ingredient) models.CatalogIngredient {
+	// empty lists, not nil - the ingredient has no allergens
+	item := models.CatalogIngredient{Name: ingredient.Name, Allergens: []string{}, Traces: []string{}}
+	for _, allergen := range ingredient.Allergens {
+		item.Allergens = append(item.Allergens, allergen.Name)
+	}
+	for _, allergen := range ingredient.Traces {
+		item.Traces = append(item.Traces, allergen.Name)
+	}
+	sort.Strings(item.Allergens)
+	sort.Strings(item.Traces)
+	return item
+}
+
+func catalogDish(dish models.Dish) models.CatalogDish {
+	item := models.CatalogDish{Name: dish.Name, Description: dish.Description, Cost: dish.Cost}
+	for _, category := range dish.Categories {
+		item.Categories = append(item.Categories, category.Name)
+	}
+	for _, ingredient := range dish.Ingredients {
+		item.Ingredients = append(item.Ingredients, ingredient.Name)
+	}
+	// the ingredients add the rest of the allergens
+	for _, allergen := range dish.ExtraAllergens {
+		item.Allergens = append(item.Allergens, allergen.Name)
+	}
+	for _, promotion := range dish.Promotions {
+		item.Promotions = append(item.Promotions, catalogPromotion(promotion))
+	}
+	sort.Strings(item.Categories)
+	sort.Strings(item.Ingredients)
+	sort.Strings(item.Allergens)
+	return item
+}
+
+func catalogPromotion(promotion models.Promotion) models.CatalogPromotion {
+	return models.CatalogPromotion{Name: promotion.Name, Type: promotion.Type,
+		StartTime: promotion.StartTime, EndTime: promotion.EndTime, DailyStart: promotion.DailyStart, DailyEnd: promotion.DailyEnd,
+		Weekdays: promotion.Weekdays, Cost: promotion.Cost, Percentage: promotion.Percentage, Amount: promotion.Amount,
+		Buy: promotion.Buy, Pay: promotion.Pay}
+}
+
+func catalogCategoryChanges(current models.CatalogCategory, item models.CatalogCategory) []string {
+	var changes []string
+
+	if item.Parent != nil && *current.Parent != *item.Parent {
+		changes = append(changes, fmt.Sprintf("parent: %q -> %q", *current.Parent, *item.Parent))
+	}
+	if item.Position != nil && *current.Position != *item.Position {
+		changes = append(changes, fmt.Sprintf("position: %d -> %d", *current.Position, *item.Position))
+	}
+
+	return append(changes, catalogPromotionChanges(current.Promotions, item.Promotions)...)
+}
+
+func catalogIngredientChanges(current models.CatalogIngredient, item models.CatalogIngredient) []string {
+	var changes []string
+
+	lists := []struct {
+		name    string
+		current []string
+		item    []string
+	}{
+		{"allergens", current.Allergens, item.Allergens},
+		{"traces", current.Traces, item.Traces},
+	}
+	for _, list := range lists {
+		if list.item == nil {
+			continue
+		}
+		sorted := append([]string{}, list.item...)
+		sort.Strings(sorted)
+		if strings.Join(list.current, "|") != strings.Join(sorted, "|") {
+			changes = append(changes, fmt.Sprintf("%s: [%s] -> [%s]", list.name, strings.Join(list.current, ", "), strings.Join(sorted, ", ")))
+		}
+	}
+
+	return changes
+}
+
+func catalogDishChanges(current models.CatalogDish, item models.CatalogDish) []string {
+	var changes []string
+
+	if current.Description != item.Description {
+		changes = append(changes, "description")
+	}
+	if current.Cost != item.Cost {
+		changes = append(changes, fmt.Sprintf("cost: %s -> %s", current.Cost, item.Cost))
+	}
+
+	lists := []struct {
+		name    string
+		current []string
+		item    []string
+	}{
+		{"categories", current.Categories, item.Categories},
+		{"ingredients", current.Ingredients, item.Ingredients},
+		{"allergens", current.Allergens, item.Allergens},
+	}
+	for _, list := range lists {
+		sorted := append([]string{}, list.item...)
+		sort.Strings(sorted)
+		if strings.Join(list.current, "|") != strings.Join(sorted, "|") {
+			changes = append(changes, fmt.Sprintf("%s: [%s] -> [%s]", list.name, strings.Join(list.current, ", "), strings.Join(sorted, ", ")))
+		}
+	}
+
+	return append(changes, catalogPromotionChanges(current.Promotions, item.Promotions)...)
+}
+
+// Promotions are only added or updated by the import
+func catalogPromotionChanges(current []models.CatalogPromotion, items []models.CatalogPromotion) []string {
+	var changes []string
+
+	for _, promotion := range items {
+		label := fmt.Sprintf("%s promotion %s", catalogPromotionType(promotion), promotion.StartTime.Format("2006-01-02"))
+		found := false
+		for _, existing := range current {
+			if catalogPromotionSame(existing, promotion) {
+				found = true
+				if catalogPromotionValues(existing) != catalogPromotionValues(promotion) {
+					changes = append(changes, fmt.Sprintf("%s: %s -> %s", label, catalogPromotionValues(existing), catalogPromotionValues(promotion)))
+				}
+			}
+		}
+		if !found {
+			changes = append(changes, label+": new")
+		}
+	}
+
+	return changes
+}
+
+// Names of categories, ingredients and allergens, including the ones only referenced by dishes
+func catalogNames(catalog models.Catalog) map[string][]string {
+	seen := make(map[string]bool)
+	names := make(map[string][]string)
+	add := func(entity string, values []string) {
+		for _, value := range values {
+			if len(value) > 0 && !seen[entity+":"+value] {
+				seen[entity+":"+value] = true
+				names[entity] = append(names[entity], value)
+			}
+		}
+	}
+
+	for _, category := range catalog.Categories {
+		if category.Parent != nil {
+			add("categories", []string{*category.Parent})
+		}
+		add("categories", []string{category.Name})
+	}
+	for _, ingredient := range catalog.Ingredients {
+		add("ingredients", []string{ingredient.Name})
+		add("allergens", ingredient.Allergens)
+		add("allergens", ingredient.Traces)
+	}
+	add("allergens", catalog.Allergens)
+	for _, dish := range catalog.Dishes {
+		add("categories", dish.Categories)
+		add("ingredients", dish.Ingredients)
+		add("allergens", dish.Allergens)
+	}
+
+	return names
+}
+
+// Promotions of a dish or of a category (the other id is 0), matched with the existing ones by type and times
+func catalogPromotionsImport(tx *gorm.DB, owner string, dishId uint64, categoryId uint64, existing []models.Promotion, items []models.CatalogPromotion) error {
+	for _, item := range items {
+		promotion := models.Promotion{Name: item.Name, Type: catalogPromotionType(item), DishID: dishId, CategoryID: categoryId,
+			StartTime: item.StartTime, EndTime: item.EndTime, DailyStart: item.DailyStart, DailyEnd: item.DailyEnd,
+			Weekdays: item.Weekdays, Cost: item.Cost, Percentage: item.Percentage, Amount: item.Amount, Buy: item.Buy, Pay: item.Pay}
+		err := promotionValidate(&promotion)
+		if err != nil {
+			return fmt.Errorf("%s: %w", owner, err)
+		}
+
+		found := false
+		for _, current := range existing {
+			if catalogPromotionSame(catalogPromotion(current), item) {
+				found = true
+				err = tx.Model(&current).Updates(map[string]interface{}{"name": promotion.Name, "cost": promotion.Cost,
+					"percentage": promotion.Percentage, "amount": promotion.Amount, "buy": promotion.Buy, "pay": promotion.Pay}).Error
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		if !found {
+			err = promotionSave(tx, promotion, func() error {
+				return tx.Omit("Dish", "Category").Create(&promotion).Error
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Catalogs exported before promotions had types only have price promotions
+func catalogPromotionType(promotion models.CatalogPromotion) string {
+	if len(promotion.Type) == 0 {
+		return models.PromotionPrice
+	}
+	return promotion.Type
+}
+
+// Same promotion when it runs at the same times - the values may change
+func catalogPromotionSame(a models.CatalogPromotion, b models.CatalogPromotion) bool {
+	return catalogPromotionType(a) == catalogPromotionType(b) && a.StartTime.Equal(b.StartTime) && a.EndTime.Equal(b.EndTime) &&
+		a.DailyStart == b.DailyStart && a.DailyEnd == b.DailyEnd && a.Weekdays == b.Weekdays
+}
+
+func catalogPromotionValues(promotion models.CatalogPromotion) string {
+	switch catalogPromotionType(promotion) {
+	case models.PromotionPercentage:
+		return fmt.Sprintf("%g%%", promotion.Percentage)
+	case models.PromotionAmount:
+		return fmt.Sprintf("-%s", promotion.Amount)
+	case models.PromotionMultiBuy:
+		return fmt.Sprintf("%d for %d", promotion.Buy, promotion.Pay)
+	default:
+		return promotion.Cost.String()
+	}
+}
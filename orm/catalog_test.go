@@ -0,0 +1,118 @@
+package orm
+
+import (
+	"encoding/json"
+	"testing"
+	"tfm_backend/models"
+	"time"
+)
+
+func TestCatalogRoundTrip(t *testing.T) {
+	start := time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+
+	// in the order of CatalogExport - position, name
+	categories := []models.Category{
+		{BaseModel: models.BaseModel{ID: 1}, Name: "principales", Position: 0},
+		{BaseModel: models.BaseModel{ID: 2}, Name: "arroces", ParentID: 1, Position: 1},
+		{BaseModel: models.BaseModel{ID: 3}, Name: "postres", Position: 1},
+	}
+	promotions := []models.Promotion{
+		{CategoryID: 2, Type: models.PromotionPercentage, StartTime: start, EndTime: end, Weekdays: "1,2", Percentage: 10},
+	}
+	ingredient := models.Ingredient{Name: "gambas",
+		Allergens: []models.Allergen{{Name: "crustaceans"}},
+		Traces:    []models.Allergen{{Name: "molluscs"}, {Name: "fish"}}}
+	dish := models.Dish{Name: "Paella", Description: "Arroz", Cost: 650,
+		Categories:     []models.Category{categories[1]},
+		Ingredients:    []models.Ingredient{ingredient, {Name: "arroz"}},
+		ExtraAllergens: []models.Allergen{{Name: "celery"}},
+		Promotions: []models.Promotion{
+			{DishID: 1, Type: models.PromotionMultiBuy, StartTime: start, EndTime: end, DailyStart: "13:00", DailyEnd: "15:00", Buy: 3, Pay: 2},
+		}}
+
+	exported := models.Catalog{
+		Categories:  catalogCategories(categories, promotions),
+		Ingredients: []models.CatalogIngredient{catalogIngredient(ingredient), catalogIngredient(models.Ingredient{Name: "arroz"})},
+		Dishes:      []models.CatalogDish{catalogDish(dish)},
+	}
+
+	data, err := json.Marshal(exported)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var imported models.Catalog
+	err = json.Unmarshal(data, &imported)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// parents are created before their subcategories
+	var order []string
+	for _, category := range imported.Categories {
+		order = append(order, category.Name)
+	}
+	if len(order) != 3 || order[0] != "principales" || order[1] != "arroces" || order[2] != "postres" {
+		t.Errorf("categories exported as %v, want parents first", order)
+	}
+
+	for i := range exported.Categories {
+		if changes := catalogCategoryChanges(exported.Categories[i], imported.Categories[i]); len(changes) > 0 {
+			t.Errorf("category %s changed by the round trip: %v", exported.Categories[i].Name, changes)
+		}
+	}
+	if parent := imported.Categories[1].Parent; parent == nil || *parent != "principales" {
+		t.Errorf("arroces parent = %v, want principales", parent)
+	}
+	if len(imported.Categories[1].Promotions) != 1 {
+		t.Errorf("arroces promotions = %v, want the category promotion", imported.Categories[1].Promotions)
+	}
+
+	for i := range exported.Ingredients {
+		if changes := catalogIngredientChanges(exported.Ingredients[i], imported.Ingredients[i]); len(changes) > 0 {
+			t.Errorf("ingredient %s changed by the round trip: %v", exported.Ingredients[i].Name, changes)
+		}
+	}
+	// no allergens is kept as an empty list, so the import clears them
+	if imported.Ingredients[1].Allergens == nil {
+		t.Error("ingredient without allergens exported as nil")
+	}
+
+	if changes := catalogDishChanges(exported.Dishes[0], imported.Dishes[0]); len(changes) > 0 {
+		t.Errorf("dish changed by the round trip: %v", changes)
+	}
+
+	// a moved category is detected
+	moved := imported.Categories[1]
+	top := ""
+	moved.Parent = &top
+	if changes := catalogCategoryChanges(exported.Categories[1], moved); len(changes) != 1 {
+		t.Errorf("moved category changes = %v, want the parent", changes)
+	}
+}
+
+// Catalogs exported before the category tree and ingredient allergens keep what the database has
+func TestCatalogNamesOnly(t *testing.T) {
+	var catalog models.Catalog
+	err := json.Unmarshal([]byte(`{"categories": ["arroces"], "ingredients": ["gambas"], "allergens": [], "dishes": []}`), &catalog)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(catalog.Categories) != 1 || catalog.Categories[0].Name != "arroces" || catalog.Categories[0].Parent != nil {
+		t.Errorf("categories = %+v", catalog.Categories)
+	}
+	if len(catalog.Ingredients) != 1 || catalog.Ingredients[0].Name != "gambas" || catalog.Ingredients[0].Allergens != nil {
+		t.Errorf("ingredients = %+v", catalog.Ingredients)
+	}
+
+	parent, position := "principales", uint(3)
+	current := models.CatalogCategory{Name: "arroces", Parent: &parent, Position: &position}
+	if changes := catalogCategoryChanges(current, catalog.Categories[0]); len(changes) > 0 {
+		t.Errorf("category without tree changes %v", changes)
+	}
+	ingredient := models.CatalogIngredient{Name: "gambas", Allergens: []string{"crustaceans"}, Traces: []string{}}
+	if changes := catalogIngredientChanges(ingredient, catalog.Ingredients[0]); len(changes) > 0 {
+		t.Errorf("ingredient without allergens changes %v", changes)
+	}
+}
@@ -9,7 +9,12 @@ import (
 )
 
 func (d *Database) CategoryCreate(category models.Category) (models.Category, error) {
-	err := d.db.Create(&category).Error
+	err := d.categoryCheckParent(d.db, category.ID, category.ParentID)
+	if err != nil {
+		return models.Category{}, err
+	}
+
+	err = d.db.Create(&category).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrDuplicatedKey) {
 			return d.CategoryFind(category.Name)
@@ -34,20 +39,39 @@ func (d *Database) CategoryDetails(categoryId uint64) (models.Category, error) {
 }
 
 func (d *Database) CategoryDelete(categoryId uint64) error {
-	var value uint64
 	// Is the category associated to any dish?
-	res := d.db.Raw(`SELECT dish_id FROM dish_categories WHERE category_id = ? LIMIT 1`, categoryId).Scan(&value)
-	if res.Error != nil {
-		log.Error().Err(res.Error).Uint64("categoryId", categoryId).Msg("Failed to find Dish with Category")
-		return res.Error
+	var dishes []models.Blocker
+	err := d.db.Raw(`SELECT 'dish' AS entity, dishes.id, dishes.name FROM dishes
+			JOIN dish_categories ON dish_categories.dish_id = dishes.id
+		WHERE dish_categories.category_id = ? AND dishes.deleted_at IS NULL ORDER BY dishes.name`, categoryId).Scan(&dishes).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("categoryId", categoryId).Msg("Failed to find Dish with Category")
+		return err
 	}
 
-	if res.RowsAffected > 0 {
-		log.Warn().Uint64("categoryId", categoryId).Msg("Dishes with Category exist - we cannot remove it")
-		return errors.New(`Dishes associated to this Category exist - Remove the Dish association first`)
+	if len(dishes) > 0 {
+		log.Warn().Uint64("categoryId", categoryId).Interface("blockers", dishes).Msg("Dishes with Category exist - we cannot remove it")
+		return &ConflictError{Message: "Dishes associated to this Category exist - Remove the Dish association first", Conflicts: dishes}
 	}
 
-	err := d.db.Unscoped().Where("id = ?", categoryId).Delete(&models.Category{}).Error
+	// Does the category have subcategories?
+	var children []models.Category
+	err = d.db.Where("parent_id = ?", categoryId).Order("position, name").Find(&children).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("categoryId", categoryId).Msg("Failed to find Category children")
+		return err
+	}
+
+	if len(children) > 0 {
+		blockers := make([]models.Blocker, 0, len(children))
+		for _, child := range children {
+			blockers = append(blockers, models.Blocker{Entity: "category", ID: child.ID, Name: child.Name})
+		}
+		log.Warn().Uint64("categoryId", categoryId).Interface("blockers", blockers).Msg("Category has children - we cannot remove it")
+		return &ConflictError{Message: "Category has subcategories - Move or remove them first", Conflicts: blockers}
+	}
+
+	err = d.db.Unscoped().Where("id = ?", categoryId).Delete(&models.Category{}).Error
 	if err != nil {
 		log.Error().Err(err).Uint64("categoryId", categoryId).Msg("Failed to delete Category")
 		return err
@@ -56,17 +80,25 @@ func (d *Database) CategoryDelete(categoryId uint64) error {
 	return nil
 }
 
-func (d *Database) CategoryDishes(categoryId uint64, limit uint64, offset uint64) ([]models.Dish, error) {
+// Dishes of the category, and of all its subcategories when descendants is set
+func (d *Database) CategoryDishes(categoryId uint64, descendants bool, limit uint64, offset uint64) ([]models.Dish, error) {
 	var err error
 	var dishes []models.Dish
 
+	categoryIds := []uint64{categoryId}
+	if descendants {
+		categoryIds, err = d.categoryDescendants(d.db, categoryId)
+		if err != nil {
+			return dishes, err
+		}
+	}
+
 	err = d.db.Preload("Allergens", func(db *gorm.DB) *gorm.DB {
 		return db.Order("allergens.name")
 	}).Preload("Categories", func(db *gorm.DB) *gorm.DB {
-		return db.Order("categories.name")
-	}).Preload("Promotions").
-		Joins("RIGHT JOIN dish_categories ON dish_categories.dish_id = dishes.id").
-		Where(`dish_categories.category_id = ?`, categoryId).
+		return db.Order("categories.position, categories.name")
+	}).Preload("Promotions").Scopes(dishesActive).
+		Where(`dishes.id IN (SELECT dish_id FROM dish_categories WHERE category_id IN ?)`, categoryIds).
 		Order("name").Limit(int(limit)).Offset(int(offset)).Find(&dishes).Error
 	if err != nil {
 		log.Error().Err(err).Uint64("categoryId", categoryId).Uint64("limit", limit).Uint64("offset", offset).Msg("Failed to read Category Dishes")
@@ -87,11 +119,12 @@ func (d *Database) CategoryFind(name string) (models.Category, error) {
 	return category, nil
 }
 
+// Flat list of categories in display order - see CategoryTree to nest them
 func (d *Database) CategoryList() ([]models.Category, error) {
 	var err error
 	var categories []models.Category
 
-	err = d.db.Order("name").Find(&categories).Error
+	err = d.db.Order("position, name").Find(&categories).Error
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to list Category")
 		return categories, err
@@ -100,12 +133,144 @@ func (d *Database) CategoryList() ([]models.Category, error) {
 	return categories, err
 }
 
+// Renames the category - CategoryReorder moves it in the tree
 func (d *Database) CategoryModify(category models.Category) (models.Category, error) {
-	err := d.db.Save(&category).Error
+	res := d.db.Model(&models.Category{}).Where("id = ?", category.ID).Update("name", category.Name)
+	if res.Error != nil {
+		log.Error().Err(res.Error).Interface("category", category).Msg("Failed to update Category")
+		return models.Category{}, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return models.Category{}, gorm.ErrRecordNotFound
+	}
+
+	return d.CategoryDetails(category.ID)
+}
+
+// Moves the categories under the parent, in the given order
+func (d *Database) CategoryReorder(order models.CategoryOrder) error {
+	var err error
+
+	tx := d.db.Begin()
+	defer tx.Rollback()
+
+	for position, categoryId := range order.IDs {
+		err = d.categoryCheckParent(tx, categoryId, order.ParentID)
+		if err != nil {
+			return err
+		}
+
+		res := tx.Model(&models.Category{}).Where("id = ?", categoryId).
+			Updates(map[string]interface{}{"parent_id": order.ParentID, "position": position})
+		if res.Error != nil {
+			log.Error().Err(res.Error).Uint64("categoryId", categoryId).Msg("Failed to reorder Category")
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+	}
+
+	err = tx.Commit().Error
 	if err != nil {
-		log.Error().Err(err).Interface("category", category).Msg("Failed to update Category")
-		return models.Category{}, err
+		log.Error().Err(err).Interface("order", order).Msg("Failed to commit reorder Category")
+		return err
 	}
 
-	return category, nil
+	return nil
+}
+
+// Nests a flat list of categories under their parents, keeping the order of the list.
+// Categories whose parent is not in the list are returned at the top level.
+func CategoryTree(categories []models.Category) []models.Category {
+	known := make(map[uint64]bool, len(categories))
+	children := make(map[uint64][]models.Category)
+	for _, category := range categories {
+		known[category.ID] = true
+	}
+	for _, category := range categories {
+		parentId := category.ParentID
+		if !known[parentId] {
+			parentId = 0
+		}
+		children[parentId] = append(children[parentId], category)
+	}
+
+	var nest func(parentId uint64) []models.Category
+	nest = func(parentId uint64) []models.Category {
+		nodes := children[parentId]
+		for i := range nodes {
+			nodes[i].Children = nest(nodes[i].ID)
+		}
+		return nodes
+	}
+
+	tree := nest(0)
+	if tree == nil {
+		tree = []models.Category{}
+	}
+	return tree
+}
+
+// IDs of the category and all its subcategories
+func (d *Database) categoryDescendants(tx *gorm.DB, categoryId uint64) ([]uint64, error) {
+	var categoryIds []uint64
+	err := tx.Raw(`WITH RECURSIVE tree AS (
+			SELECT id FROM categories WHERE id = ? AND deleted_at IS NULL
+			UNION
+			SELECT categories.id FROM categories JOIN tree ON categories.parent_id = tree.id WHERE categories.deleted_at IS NULL
+		) SELECT id FROM tree`, categoryId).Scan(&categoryIds).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("categoryId", categoryId).Msg("Failed to read Category descendants")
+		return nil, err
+	}
+
+	return categoryIds, nil
+}
+
+// IDs of the categories of the dish and all their parents
+func (d *Database) categoryAncestors(tx *gorm.DB, dishId uint64) ([]uint64, error) {
+	var categoryIds []uint64
+	err := tx.Raw(`WITH RECURSIVE tree AS (
+			SELECT categories.id, categories.parent_id FROM categories
+				JOIN dish_categories ON dish_categories.category_id = categories.id
+			WHERE dish_categories.dish_id = ? AND categories.deleted_at IS NULL
+			UNION
+			SELECT categories.id, categories.parent_id FROM categories JOIN tree ON categories.id = tree.parent_id WHERE categories.deleted_at IS NULL
+		) SELECT id FROM tree`, dishId).Scan(&categoryIds).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("dishId", dishId).Msg("Failed to read Dish category ancestors")
+		return nil, err
+	}
+
+	return categoryIds, nil
+}
+
+// The parent must exist, and cannot be the category itself or one of its subcategories
+func (d *Database) categoryCheckParent(tx *gorm.DB, categoryId uint64, parentId uint64) error {
+	if parentId == 0 {
+		return nil
+	}
+
+	err := tx.First(&models.Category{}, parentId).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("parentId", parentId).Msg("Failed to find parent Category")
+		return err
+	}
+
+	if categoryId == 0 {
+		return nil
+	}
+
+	descendants, err := d.categoryDescendants(tx, categoryId)
+	if err != nil {
+		return err
+	}
+	for _, id := range descendants {
+		if id == parentId {
+			return validationErrorf("category %d cannot be moved inside itself or its subcategories", categoryId)
+		}
+	}
+
+	return nil
 }
@@ -0,0 +1,72 @@
+package orm
+
+import (
+	"tfm_backend/models"
+
+	"github.com/rs/zerolog/log"
+)
+
+func (d *Database) CompanyCreate(company models.Company) (models.Company, error) {
+	err := d.db.Create(&company).Error
+	if err != nil {
+		log.Error().Err(err).Interface("company", company).Msg("Failed to create Company")
+		return models.Company{}, err
+	}
+
+	return company, nil
+}
+
+func (d *Database) CompanyDelete(companyId uint64) error {
+	var employees []models.User
+	err := d.db.Select("id", "email").Where("company_id = ?", companyId).Order("email").Find(&employees).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("companyId", companyId).Msg("Failed to find Company employees")
+		return err
+	}
+
+	if len(employees) > 0 {
+		blockers := make([]models.Blocker, 0, len(employees))
+		for _, employee := range employees {
+			blockers = append(blockers, models.Blocker{Entity: "user", ID: employee.ID, Name: employee.Email})
+		}
+		return &ConflictError{Message: "Company has employees - move them to another company first", Conflicts: blockers}
+	}
+
+	err = d.db.Delete(&models.Company{}, companyId).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("companyId", companyId).Msg("Failed to delete Company")
+		return err
+	}
+
+	return nil
+}
+
+func (d *Database) CompanyDetails(companyId uint64) (models.Company, error) {
+	var company models.Company
+	err := d.db.First(&company, companyId).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("companyId", companyId).Msg("Failed to detail Company")
+		return models.Company{}, err
+	}
+
+	return company, nil
+}
+
+func (d *Database) CompanyList(limit uint64, offset uint64) ([]models.Company, error) {
+	var companies []models.Company
+	err := d.db.Order("name").Limit(int(limit)).Offset(int(offset)).Find(&companies).Error
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list Companies")
+	}
+	return companies, err
+}
+
+func (d *Database) CompanyModify(company models.Company) (models.Company, error) {
+	err := d.db.Updates(&company).Error
+	if err != nil {
+		log.Error().Err(err).Interface("company", company).Msg("Failed to update Company")
+		return models.Company{}, err
+	}
+
+	return d.CompanyDetails(company.ID)
+}
@@ -1,8 +1,8 @@
 package orm
 
 import (
-	"errors"
-	"fmt"
+	"strconv"
+	"strings"
 	"tfm_backend/models"
 	"time"
 
@@ -17,20 +17,39 @@ func (d *Database) ConfigurationDetails() (models.Configuration, error) {
 	return config, err
 }
 
+// Saves every setting, zero values included - no pre-orders, no tax or deliveries every day are valid settings
 func (d *Database) ConfigurationModify(config models.Configuration) (models.Configuration, error) {
-	err := d.db.Model(&config).Updates(&config).Error
+	err := weekdaysValidate(config.BusinessDays)
 	if err != nil {
+		return config, validationErrorf("business %s", err)
+	}
+
+	err = d.db.Model(&models.Configuration{}).Where("id = ?", config.ID).Updates(map[string]interface{}{
+		"delivery_time":             config.DeliveryTime,
+		"changes_time":              config.ChangesTime,
+		"subvention":                config.Subvention,
+		"suggestion_min_support":    config.SuggestionMinSupport,
+		"suggestion_min_confidence": config.SuggestionMinConfidence,
+		"preorder_days":             config.PreorderDays,
+		"business_days":             config.BusinessDays,
+		"tax_rate":                  config.TaxRate,
+		"business_name":             config.BusinessName,
+		"business_tax_id":           config.BusinessTaxID,
+		"business_address":          config.BusinessAddress,
+	}).Error
+	if err != nil {
+		log.Error().Err(err).Interface("config", config).Msg("Failed to update config")
 		return config, err
 	}
 
 	return d.ConfigurationDetails()
 }
 
+// Orders can be changed until ChangesTime of their delivery day
 func (d *Database) configChangesAllowed(orderDelivery time.Time) error {
-	if orderDelivery.Day() != time.Now().Day() ||
-		orderDelivery.Month() != time.Now().Month() ||
-		orderDelivery.Year() != time.Now().Year() {
-		return errors.New("this order has been already delivered")
+	today := time.Date(time.Now().Year(), time.Now().Month(), time.Now().Day(), 0, 0, 0, 0, time.Now().Location())
+	if orderDelivery.Before(today) {
+		return validationErrorf("this order has been already delivered")
 	}
 
 	// Read ChangesTime
@@ -46,13 +65,13 @@ func (d *Database) configChangesAllowed(orderDelivery time.Time) error {
 		config.ChangesTime.Hour(), config.ChangesTime.Minute(), 0, 0, orderDelivery.Location())
 
 	if time.Now().After(orderLimit) {
-		return fmt.Errorf("kitchen is closed, no more orders or changes allowed after %s", orderLimit.Format("15:04"))
+		return validationErrorf("kitchen is closed, no more orders or changes allowed after %s", orderLimit.Format("15:04"))
 	}
 
 	return nil
 }
 
-func (d *Database) configSubvention() (float64, error) {
+func (d *Database) configSubvention() (models.Money, error) {
 	// Read Subvention
 	var config models.Configuration
 	err := d.db.Select("subvention").First(&config).Error
@@ -64,6 +83,38 @@ func (d *Database) configSubvention() (float64, error) {
 	return config.Subvention, nil
 }
 
+// Delivery time of an order for the day (zero = today), which must be a business day within the pre-order horizon
+func (d *Database) configDelivery(day time.Time) (time.Time, error) {
+	// today is checked as any other day, it must be a business day too
+	if day.IsZero() {
+		day = time.Now()
+	}
+
+	var config models.Configuration
+	err := d.db.Select("delivery_time", "preorder_days", "business_days").First(&config).Error
+	if err != nil {
+		log.Error().Err(err).Msg(errMsgReadConfig)
+		return time.Now(), err
+	}
+
+	day = day.In(time.Now().Location())
+	delivery := time.Date(day.Year(), day.Month(), day.Day(), config.DeliveryTime.Hour(), config.DeliveryTime.Minute(), 0, 0, time.Now().Location())
+
+	today := time.Date(time.Now().Year(), time.Now().Month(), time.Now().Day(), 0, 0, 0, 0, time.Now().Location())
+	if delivery.Before(today) {
+		return delivery, validationErrorf("orders cannot be placed for past days")
+	}
+	if delivery.After(today.AddDate(0, 0, int(config.PreorderDays)+1)) {
+		return delivery, validationErrorf("orders can only be placed up to %d days ahead", config.PreorderDays)
+	}
+
+	if !weekdaysContain(config.BusinessDays, delivery.Weekday()) {
+		return delivery, validationErrorf("there are no deliveries on %s", delivery.Weekday())
+	}
+
+	return delivery, nil
+}
+
 func (d *Database) configTodayDelivery() (time.Time, error) {
 	// Read DeliveryTime
 	var config models.Configuration
@@ -76,3 +127,34 @@ func (d *Database) configTodayDelivery() (time.Time, error) {
 	todayDelivery := time.Date(time.Now().Year(), time.Now().Month(), time.Now().Day(), config.DeliveryTime.Hour(), config.DeliveryTime.Minute(), 0, 0, time.Now().Location())
 	return todayDelivery, nil
 }
+
+// Lists of days of the week like "1,2,3,4,5" (0 = Sunday) - an empty list contains every day
+func weekdaysContain(weekdays string, day time.Weekday) bool {
+	if len(weekdays) == 0 {
+		return true
+	}
+
+	for _, value := range strings.Split(weekdays, ",") {
+		weekday, err := strconv.Atoi(strings.TrimSpace(value))
+		if err == nil && time.Weekday(weekday) == day {
+			return true
+		}
+	}
+
+	return false
+}
+
+func weekdaysValidate(weekdays string) error {
+	if len(weekdays) == 0 {
+		return nil
+	}
+
+	for _, value := range strings.Split(weekdays, ",") {
+		weekday, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || weekday < 0 || weekday > 6 {
+			return validationErrorf("week days must be 0 (Sunday) to 6: %s", value)
+		}
+	}
+
+	return nil
+}
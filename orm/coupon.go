@@ -0,0 +1,394 @@
+package orm
+
+import (
+	"errors"
+	"strings"
+	"tfm_backend/models"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (d *Database) CouponCreate(coupon models.Coupon) (models.Coupon, error) {
+	err := couponValidate(&coupon)
+	if err != nil {
+		return coupon, err
+	}
+
+	err = d.db.Where("code = ?", coupon.Code).First(&models.Coupon{}).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = d.db.Create(&coupon).Error
+		if err != nil {
+			log.Error().Err(err).Interface("coupon", coupon).Msg("Failed to create Coupon")
+			return coupon, err
+		}
+		return d.CouponDetails(coupon.ID)
+	}
+
+	if err != nil {
+		return coupon, err
+	}
+
+	// No error, we have found a matching coupon - return duplicated error
+	return coupon, gorm.ErrDuplicatedKey
+}
+
+func (d *Database) CouponDelete(couponId uint64) error {
+	return d.db.Delete(&models.Coupon{}, couponId).Error
+}
+
+func (d *Database) CouponDetails(couponId uint64) (models.Coupon, error) {
+	var coupon models.Coupon
+	err := d.db.Preload("Dishes", func(db *gorm.DB) *gorm.DB {
+		return db.Order("dishes.name")
+	}).Preload("Categories", func(db *gorm.DB) *gorm.DB {
+		return db.Order("categories.position, categories.name")
+	}).First(&coupon, couponId).Error
+	return coupon, err
+}
+
+func (d *Database) CouponList(limit uint64, offset uint64) ([]models.Coupon, error) {
+	var coupons []models.Coupon
+	err := d.db.Preload("Dishes", func(db *gorm.DB) *gorm.DB {
+		return db.Order("dishes.name")
+	}).Preload("Categories", func(db *gorm.DB) *gorm.DB {
+		return db.Order("categories.position, categories.name")
+	}).Order("start_time DESC").Limit(int(limit)).Offset(int(offset)).Find(&coupons).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("limit", limit).Uint64("offset", offset).Msg("Failed to list Coupons")
+	}
+	return coupons, err
+}
+
+func (d *Database) CouponModify(coupon models.Coupon) (models.Coupon, error) {
+	var err error
+
+	tx := d.db.Begin()
+	defer tx.Rollback()
+
+	coupon.Code = strings.ToUpper(strings.TrimSpace(coupon.Code))
+	// redemptions are counted by the orders, never by the administrator
+	err = tx.Omit("Dishes", "Categories", "Uses").Updates(&coupon).Error
+	if err != nil {
+		log.Error().Err(err).Interface("coupon", coupon).Msg("Failed to update Coupon")
+		return coupon, err
+	}
+
+	// replace restrictions - Update adds new records, but doesn't delete old ones
+	err = tx.Model(&coupon).Association("Dishes").Replace(coupon.Dishes)
+	if err != nil {
+		log.Error().Err(err).Interface("coupon", coupon).Msg("Failed to replace Coupon dishes")
+		return coupon, err
+	}
+
+	err = tx.Model(&coupon).Association("Categories").Replace(coupon.Categories)
+	if err != nil {
+		log.Error().Err(err).Interface("coupon", coupon).Msg("Failed to replace Coupon categories")
+		return coupon, err
+	}
+
+	// Updates ignores empty values - validate the coupon as it has been saved
+	var saved models.Coupon
+	err = tx.First(&saved, coupon.ID).Error
+	if err != nil {
+		return coupon, err
+	}
+	err = couponValidate(&saved)
+	if err != nil {
+		return coupon, err
+	}
+
+	err = tx.Commit().Error
+	if err != nil {
+		log.Error().Err(err).Interface("coupon", coupon).Msg("Failed to commit modify Coupon")
+		return coupon, err
+	}
+
+	return d.CouponDetails(coupon.ID)
+}
+
+// Applies a coupon to an order before the kitchen cutoff, replacing the previous one.
+// The coupon row is locked, so concurrent redemptions cannot go over the usage limits.
+func (d *Database) OrderCouponApply(userId uint64, orderId uint64, code string) (models.Order, error) {
+	var err error
+
+	_, err = d.OrderModifiable(orderId, userId)
+	if err != nil {
+		return models.Order{}, err
+	}
+
+	tx := d.db.Begin()
+	defer tx.Rollback()
+
+	var order models.Order
+	err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&order, orderId).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", orderId).Msg("Failed to read order for coupon")
+		return models.Order{}, err
+	}
+
+	var coupon models.Coupon
+	err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("code = ?", strings.ToUpper(strings.TrimSpace(code))).First(&coupon).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return models.Order{}, validationErrorf("coupon code is not valid")
+	}
+	if err != nil {
+		log.Error().Err(err).Str("code", code).Msg("Failed to read coupon")
+		return models.Order{}, err
+	}
+
+	if order.CouponID == coupon.ID {
+		// already applied
+		return d.OrderDetails(int64(userId), orderId)
+	}
+
+	now := time.Now()
+	if now.Before(coupon.StartTime) || now.After(coupon.EndTime) {
+		return models.Order{}, validationErrorf("coupon %s is not valid now", coupon.Code)
+	}
+
+	// the previous coupon of the order is released
+	err = orderCouponRelease(tx, order)
+	if err != nil {
+		return models.Order{}, err
+	}
+
+	if coupon.MaxUses > 0 && coupon.Uses >= coupon.MaxUses {
+		return models.Order{}, validationErrorf("coupon %s has been used up", coupon.Code)
+	}
+
+	if coupon.MaxUsesUser > 0 {
+		var uses int64
+		err = tx.Model(&models.CouponRedemption{}).Where("coupon_id = ? AND user_id = ?", coupon.ID, userId).Count(&uses).Error
+		if err != nil {
+			log.Error().Err(err).Uint64("couponId", coupon.ID).Uint64("userId", userId).Msg("Failed to count coupon redemptions")
+			return models.Order{}, err
+		}
+		if uses >= int64(coupon.MaxUsesUser) {
+			return models.Order{}, validationErrorf("coupon %s cannot be used more times", coupon.Code)
+		}
+	}
+
+	var lines []models.OrderLine
+	err = tx.Select("dish_id", "set_menu_id", "cost_unit", "quantity").Where("order_id = ?", orderId).Find(&lines).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", orderId).Msg("Failed to read order lines for coupon")
+		return models.Order{}, err
+	}
+
+	discount, err := d.couponDiscount(tx, coupon, lines, order.Discount)
+	if err != nil {
+		return models.Order{}, err
+	}
+	if discount == 0 {
+		return models.Order{}, validationErrorf("coupon %s doesn't apply to this order", coupon.Code)
+	}
+
+	err = tx.Create(&models.CouponRedemption{CouponID: coupon.ID, UserID: userId, OrderID: orderId}).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("couponId", coupon.ID).Uint64("orderId", orderId).Msg("Failed to redeem coupon")
+		return models.Order{}, err
+	}
+
+	err = tx.Model(&coupon).UpdateColumn("uses", gorm.Expr("uses + 1")).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("couponId", coupon.ID).Msg("Failed to count coupon use")
+		return models.Order{}, err
+	}
+
+	err = tx.Model(&order).Updates(map[string]interface{}{"coupon_id": coupon.ID, "coupon_code": coupon.Code}).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", orderId).Msg("Failed to apply coupon to order")
+		return models.Order{}, err
+	}
+
+	err = d.orderUpdateCost(tx, orderId)
+	if err != nil {
+		return models.Order{}, err
+	}
+
+	err = tx.Commit().Error
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", orderId).Msg(errMsgTxCommit)
+		return models.Order{}, err
+	}
+
+	return d.OrderDetails(int64(userId), orderId)
+}
+
+// Removes the coupon of an order before the kitchen cutoff, the coupon can be used again
+func (d *Database) OrderCouponRemove(userId uint64, orderId uint64) (models.Order, error) {
+	var err error
+
+	_, err = d.OrderModifiable(orderId, userId)
+	if err != nil {
+		return models.Order{}, err
+	}
+
+	tx := d.db.Begin()
+	defer tx.Rollback()
+
+	var order models.Order
+	err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&order, orderId).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", orderId).Msg("Failed to read order for coupon")
+		return models.Order{}, err
+	}
+
+	err = orderCouponRelease(tx, order)
+	if err != nil {
+		return models.Order{}, err
+	}
+
+	err = d.orderUpdateCost(tx, orderId)
+	if err != nil {
+		return models.Order{}, err
+	}
+
+	err = tx.Commit().Error
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", orderId).Msg(errMsgTxCommit)
+		return models.Order{}, err
+	}
+
+	return d.OrderDetails(int64(userId), orderId)
+}
+
+// Discount of the coupon for the order lines, after the order promotions.
+// Returns 0 if the order doesn't reach the minimum cost.
+func (d *Database) couponDiscount(tx *gorm.DB, coupon models.Coupon, lines []models.OrderLine, promotionDiscount models.Money) (models.Money, error) {
+	var subtotal models.Money
+	for _, line := range lines {
+		subtotal += line.CostUnit.Times(line.Quantity)
+	}
+	subtotal -= promotionDiscount
+	if subtotal <= 0 || subtotal < coupon.MinOrder {
+		return 0, nil
+	}
+
+	// restrictions are loaded here - the coupon may come from a locked read without preloads
+	var dishIds, categoryIds []uint64
+	err := tx.Raw(`SELECT dish_id FROM coupon_dishes WHERE coupon_id = ?`, coupon.ID).Scan(&dishIds).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("couponId", coupon.ID).Msg("Failed to read coupon dishes")
+		return 0, err
+	}
+	err = tx.Raw(`SELECT category_id FROM coupon_categories WHERE coupon_id = ?`, coupon.ID).Scan(&categoryIds).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("couponId", coupon.ID).Msg("Failed to read coupon categories")
+		return 0, err
+	}
+
+	eligible := subtotal
+	if len(dishIds) > 0 || len(categoryIds) > 0 {
+		allowed := make(map[uint64]bool)
+		for _, id := range dishIds {
+			allowed[id] = true
+		}
+		categories := make(map[uint64]bool)
+		for _, id := range categoryIds {
+			categories[id] = true
+		}
+
+		eligible = 0
+		for _, line := range lines {
+			if line.DishID == 0 || line.SetMenuID > 0 {
+				continue
+			}
+
+			if !allowed[line.DishID] && len(categories) > 0 {
+				ancestors, err := d.categoryAncestors(tx, line.DishID)
+				if err != nil {
+					return 0, err
+				}
+				for _, id := range ancestors {
+					if categories[id] {
+						allowed[line.DishID] = true
+					}
+				}
+			}
+
+			if allowed[line.DishID] {
+				eligible += line.CostUnit.Times(line.Quantity)
+			}
+		}
+		if eligible > subtotal {
+			eligible = subtotal
+		}
+	}
+
+	var discount models.Money
+	switch coupon.Type {
+	case models.CouponPercentage:
+		discount = eligible.Percentage(coupon.Percentage)
+	default:
+		discount = coupon.Amount
+		if discount > eligible {
+			discount = eligible
+		}
+	}
+
+	return discount, nil
+}
+
+func couponValidate(coupon *models.Coupon) error {
+	coupon.Code = strings.ToUpper(strings.TrimSpace(coupon.Code))
+	if len(coupon.Type) == 0 {
+		coupon.Type = models.CouponAmount
+	}
+
+	if len(coupon.Code) == 0 {
+		return validationErrorf("coupon code cannot be empty")
+	}
+	if coupon.EndTime.Before(coupon.StartTime) {
+		return validationErrorf("coupon ends before it starts")
+	}
+	if coupon.MinOrder < 0 {
+		return validationErrorf("coupon minimum order cannot be negative")
+	}
+
+	switch coupon.Type {
+	case models.CouponAmount:
+		if coupon.Amount <= 0 {
+			return validationErrorf("coupon amount must be positive")
+		}
+	case models.CouponPercentage:
+		if coupon.Percentage <= 0 || coupon.Percentage > 100 {
+			return validationErrorf("coupon percentage must be between 0 and 100")
+		}
+	default:
+		return validationErrorf("unknown coupon type %s", coupon.Type)
+	}
+
+	return nil
+}
+
+// Frees the coupon of the order - the order cost has to be updated after it
+func orderCouponRelease(tx *gorm.DB, order models.Order) error {
+	if order.CouponID == 0 {
+		return nil
+	}
+
+	res := tx.Unscoped().Where("order_id = ?", order.ID).Delete(&models.CouponRedemption{})
+	if res.Error != nil {
+		log.Error().Err(res.Error).Uint64("orderId", order.ID).Msg("Failed to delete coupon redemption")
+		return res.Error
+	}
+
+	if res.RowsAffected > 0 {
+		err := tx.Model(&models.Coupon{}).Where("id = ? AND uses > 0", order.CouponID).UpdateColumn("uses", gorm.Expr("uses - 1")).Error
+		if err != nil {
+			log.Error().Err(err).Uint64("couponId", order.CouponID).Msg("Failed to release coupon use")
+			return err
+		}
+	}
+
+	err := tx.Model(&order).Updates(map[string]interface{}{"coupon_id": 0, "coupon_code": "", "coupon_discount": 0}).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", order.ID).Msg("Failed to remove coupon from order")
+	}
+
+	return err
+}
@@ -1,18 +1,93 @@
 package orm
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
 	"tfm_backend/models"
+	"tfm_backend/payments"
 
 	"github.com/rs/zerolog/log"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
 
+type migration struct {
+	name      string
+	statement string
+}
+
+// Changes AutoMigrate cannot do, applied once and in order
+var migrations = []migration{
+	// dish names are unique only for not deleted dishes (ix_dish_name)
+	{"dishes_name_partial_unique", `ALTER TABLE dishes DROP CONSTRAINT IF EXISTS dishes_name_key`},
+	// dish allergens were maintained by hand - keep them as extra allergens
+	{"dish_extra_allergens", `INSERT INTO dish_extra_allergens (dish_id, allergen_id)
+		SELECT dish_id, allergen_id FROM dish_allergens ON CONFLICT DO NOTHING`},
+	// category promotions have no dish
+	{"promotions_without_dish", `ALTER TABLE promotions
+		DROP CONSTRAINT IF EXISTS fk_dishes_promotions, DROP CONSTRAINT IF EXISTS fk_promotions_dish`},
+	// promotions ending when they start never ran - they are removed
+	{"promotions_dates", `UPDATE promotions SET end_time = start_time + interval '1 second', deleted_at = COALESCE(deleted_at, now())
+		WHERE end_time <= start_time;
+		ALTER TABLE promotions ADD CONSTRAINT promotions_dates CHECK (end_time > start_time)`},
+	// orders of past days were delivered before orders had a status
+	{"orders_status_delivered", `UPDATE orders SET status = 'delivered' WHERE delivery < date_trunc('day', now())`},
+	// money columns were double precision - AutoMigrate casts them to numeric(12,2), rounding each value to the cent,
+	// so order totals are calculated again from the rounded lines
+	{"money_order_totals", `UPDATE orders SET cost_total = GREATEST(l.total - orders.discount - orders.coupon_discount, 0)
+		FROM (SELECT order_id, SUM(cost_unit * quantity) AS total FROM order_lines WHERE deleted_at IS NULL GROUP BY order_id) l
+		WHERE l.order_id = orders.id`},
+	{"money_order_to_pay", `UPDATE orders SET cost_to_pay = GREATEST(cost_total - subvention, 0)`},
+	// orders were deleted by their owner, they are cancelled now and kept in the history
+	{"orders_deleted_cancelled", `UPDATE orders SET status = 'cancelled', cancelled_by = user_id, cancel_reason = 'customer', deleted_at = NULL
+		WHERE deleted_at IS NOT NULL`},
+	// a zero credit limit meant no limit, it means no credit now
+	{"wallets_credit_limit_null", `UPDATE wallets SET credit_limit = NULL WHERE credit_limit = 0`},
+	// debits given back were posted as credits
+	{"wallet_reversals", `UPDATE wallet_transactions SET type = 'reversal' WHERE type = 'credit' AND order_id > 0`},
+	// minutes of the week a promotion runs (0 = Sunday 00:00), from its week days and happy hour - see promotionRunsAt
+	{"promotion_schedule", `CREATE OR REPLACE FUNCTION promotion_schedule(weekdays text, daily_start text, daily_end text)
+		RETURNS int4multirange LANGUAGE sql IMMUTABLE AS $$
+		WITH days AS (
+			SELECT unnest(CASE WHEN COALESCE(weekdays, '') = '' THEN '{0,1,2,3,4,5,6}'::int[]
+				ELSE string_to_array(replace(weekdays, ' ', ''), ',')::int[] END) AS day
+		), clock AS (
+			SELECT split_part(daily_start, ':', 1)::int * 60 + split_part(daily_start, ':', 2)::int AS s,
+				split_part(daily_end, ':', 1)::int * 60 + split_part(daily_end, ':', 2)::int AS e
+			WHERE COALESCE(daily_start, '') <> '' AND COALESCE(daily_end, '') <> ''
+		), windows AS (
+			SELECT 0 AS s, 1440 AS e WHERE COALESCE(daily_start, '') = '' OR COALESCE(daily_end, '') = ''
+			UNION ALL SELECT s, e FROM clock WHERE s <= e
+			UNION ALL SELECT s, 1440 FROM clock WHERE s > e
+			UNION ALL SELECT 0, e FROM clock WHERE s > e
+		)
+		SELECT COALESCE(range_agg(int4range(day * 1440 + s, day * 1440 + e)), '{}') FROM days, windows
+		$$`},
+	// unit price promotions of the same dish or category don't run at the same time, nor do multi-buy promotions.
+	// Overlapping promotions saved without the constraint are removed, the oldest one is kept
+	{"promotions_no_overlap_schedule", `ALTER TABLE promotions DROP CONSTRAINT IF EXISTS promotions_no_overlap;
+		UPDATE promotions p SET deleted_at = now() WHERE p.deleted_at IS NULL AND EXISTS (
+			SELECT 1 FROM promotions o WHERE o.deleted_at IS NULL AND o.id < p.id
+				AND o.dish_id = p.dish_id AND o.category_id = p.category_id AND (o.type = 'multibuy') = (p.type = 'multibuy')
+				AND tstzrange(o.start_time, o.end_time, '[)') && tstzrange(p.start_time, p.end_time, '[)')
+				AND promotion_schedule(o.weekdays, o.daily_start, o.daily_end) && promotion_schedule(p.weekdays, p.daily_start, p.daily_end));
+		ALTER TABLE promotions ADD CONSTRAINT promotions_no_overlap EXCLUDE USING gist (dish_id WITH =, category_id WITH =,
+			(CASE WHEN type = 'multibuy' THEN 1 ELSE 0 END) WITH =, tstzrange(start_time, end_time, '[)') WITH &&,
+			promotion_schedule(weekdays, daily_start, daily_end) WITH &&) WHERE (deleted_at IS NULL)`},
+}
+
+// The 14 allergens regulated by the EU (Regulation 1169/2011, Annex II)
+var regulatedAllergens = []string{
+	"gluten", "crustaceans", "eggs", "fish", "peanuts", "soybeans", "milk",
+	"nuts", "celery", "mustard", "sesame", "sulphites", "lupin", "molluscs",
+}
+
 type Database struct {
 	cfg        *models.ConfigDatabase
+	cfgPayment *models.ConfigPayments
+	payments   payments.Provider
 	siteAdmin  *models.User
 	siteConfig *models.Configuration
 	db         *gorm.DB
@@ -20,19 +95,49 @@ type Database struct {
 }
 
 func NewDatabase(cfg *models.Config) *Database {
-	d := Database{cfg: &cfg.Database, siteAdmin: &cfg.SiteAdmin, siteConfig: &cfg.SiteConfig}
+	d := Database{cfg: &cfg.Database, cfgPayment: &cfg.Payments, siteAdmin: &cfg.SiteAdmin, siteConfig: &cfg.SiteConfig}
 
+	d.models = append(d.models, &models.SchemaMigration{})
 	d.models = append(d.models, &models.Configuration{})
+	d.models = append(d.models, &models.Company{})
 	d.models = append(d.models, &models.User{})
+	d.models = append(d.models, &models.Wallet{})
+	d.models = append(d.models, &models.WalletTransaction{})
 	d.models = append(d.models, &models.Category{})
 	d.models = append(d.models, &models.Ingredient{})
 	d.models = append(d.models, &models.Allergen{})
+	d.models = append(d.models, &models.Translation{})
 	d.models = append(d.models, &models.Dish{})
+	d.models = append(d.models, &models.DishPrice{})
+	d.models = append(d.models, &models.ModifierGroup{})
+	d.models = append(d.models, &models.Modifier{})
 	d.models = append(d.models, &models.Promotion{})
+	d.models = append(d.models, &models.SetMenu{})
+	d.models = append(d.models, &models.SetMenuCourse{})
+	d.models = append(d.models, &models.Coupon{})
+	d.models = append(d.models, &models.SubventionRule{})
+	d.models = append(d.models, &models.CouponRedemption{})
 	d.models = append(d.models, &models.Order{})
 	d.models = append(d.models, &models.OrderLine{})
+	d.models = append(d.models, &models.OrderLineChoice{})
+	d.models = append(d.models, &models.OrderLineModifier{})
+	d.models = append(d.models, &models.OrderDiscount{})
+	d.models = append(d.models, &models.OrderStatusChange{})
+	d.models = append(d.models, &models.Subscription{})
+	d.models = append(d.models, &models.SubscriptionLine{})
+	d.models = append(d.models, &models.SubscriptionLineChoice{})
+	d.models = append(d.models, &models.SubscriptionLineModifier{})
+	d.models = append(d.models, &models.SubscriptionSkip{})
+	d.models = append(d.models, &models.Notification{})
+	d.models = append(d.models, &models.IdempotencyKey{})
+	d.models = append(d.models, &models.PaymentRefund{})
 	d.models = append(d.models, &models.DishDislike{})
 	d.models = append(d.models, &models.DishLike{})
+	d.models = append(d.models, &models.DishReview{})
+	d.models = append(d.models, &models.DishRecommendation{})
+	d.models = append(d.models, &models.DishAssociation{})
+	d.models = append(d.models, &models.Invoice{})
+	d.models = append(d.models, &models.InvoiceCounter{})
 
 	return &d
 }
@@ -59,6 +164,12 @@ func (d *Database) Setup() error {
 	sqlDb.SetMaxIdleConns(d.cfg.MaxIdleConns)
 	sqlDb.SetMaxOpenConns(d.cfg.MaxOpenConns)
 
+	d.payments, err = payments.NewProvider(*d.cfgPayment)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to create payment provider")
+		return err
+	}
+
 	if d.cfg.Reset {
 		err = d.autoReset()
 		if err != nil {
@@ -71,6 +182,11 @@ func (d *Database) Setup() error {
 		return err
 	}
 
+	err = d.autoSeed()
+	if err != nil {
+		return err
+	}
+
 	if d.cfg.Reset {
 		err = d.autoInit()
 		if err != nil {
@@ -126,5 +242,55 @@ func (d *Database) autoMigrate() error {
 		}
 	}
 
+	for _, m := range migrations {
+		err = d.migrate(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *Database) autoSeed() error {
+	log.Info().Msg("Seeding regulated allergens")
+
+	for _, name := range regulatedAllergens {
+		err := d.db.Exec(`INSERT INTO allergens (name, regulated, created_at, updated_at) VALUES (?, true, ?, ?)
+			ON CONFLICT (name) DO UPDATE SET regulated = true`, name, time.Now(), time.Now()).Error
+		if err != nil {
+			log.Error().Err(err).Str("name", name).Msg("Failed to seed allergen")
+			return err
+		}
+	}
+
 	return nil
 }
+
+func (d *Database) migrate(m migration) error {
+	err := d.db.Where("name = ?", m.name).First(&models.SchemaMigration{}).Error
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	log.Info().Str("migration", m.name).Msg("Applying migration")
+
+	tx := d.db.Begin()
+	defer tx.Rollback()
+
+	err = tx.Exec(m.statement).Error
+	if err != nil {
+		log.Error().Err(err).Str("migration", m.name).Msg("Failed to migrate")
+		return err
+	}
+
+	err = tx.Create(&models.SchemaMigration{Name: m.name, AppliedAt: time.Now()}).Error
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit().Error
+}
@@ -2,8 +2,8 @@ package orm
 
 import (
 	"errors"
-	"fmt"
 	"tfm_backend/models"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
@@ -15,11 +15,40 @@ func (d *Database) DishCount() (int64, error) {
 	return count, err
 }
 
-func (d *Database) DishCreate(dish models.Dish) (models.Dish, error) {
+func (d *Database) DishCreate(dish models.Dish, userId uint64) (models.Dish, error) {
 	err := d.db.Where("name = ?", dish.Name).First(&models.Dish{}).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
-		err := d.db.Create(&dish).Error
-		return dish, err
+		tx := d.db.Begin()
+		defer tx.Rollback()
+
+		// allergens are calculated from the ingredients, the ones sent are extra allergens
+		if dish.ExtraAllergens == nil {
+			dish.ExtraAllergens = dish.Allergens
+		}
+		dish.Allergens = nil
+		dish.Traces = nil
+
+		err = tx.Create(&dish).Error
+		if err != nil {
+			return dish, err
+		}
+
+		err = dishUpdateAllergens(tx, []uint64{dish.ID})
+		if err != nil {
+			return dish, err
+		}
+
+		// initial price
+		err = d.dishPriceRecord(tx, dish.ID, dish.Cost, userId)
+		if err != nil {
+			return dish, err
+		}
+
+		err = tx.Commit().Error
+		if err != nil {
+			return dish, err
+		}
+		return d.DishDetails(dish.ID)
 	}
 
 	if err != nil {
@@ -30,20 +59,81 @@ func (d *Database) DishCreate(dish models.Dish) (models.Dish, error) {
 	return dish, gorm.ErrDuplicatedKey
 }
 
+func (d *Database) DishArchive(dishId uint64, archived bool) (models.Dish, error) {
+	var err error
+
+	tx := d.db.Begin()
+	defer tx.Rollback()
+
+	err = tx.Model(&models.Dish{}).Where("id = ?", dishId).Update("archived", archived).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("dishId", dishId).Bool("archived", archived).Msg("Failed to archive dish")
+		return models.Dish{}, err
+	}
+
+	if archived {
+		err = dishEndPromotions(tx, dishId)
+		if err != nil {
+			return models.Dish{}, err
+		}
+	}
+
+	err = tx.Commit().Error
+	if err != nil {
+		log.Error().Err(err).Uint64("dishId", dishId).Msg("Failed to commit archive dish")
+		return models.Dish{}, err
+	}
+
+	return d.DishDetails(dishId)
+}
+
+// Deletes a dish not referenced by pending orders or set menus, ending its promotions
 func (d *Database) DishDelete(dishId uint64) error {
-	return d.db.Delete(&models.Dish{}, dishId).Error
+	var err error
+
+	blockers, err := d.dishBlockers(dishId)
+	if err != nil {
+		return err
+	}
+	if len(blockers) > 0 {
+		log.Warn().Uint64("dishId", dishId).Interface("blockers", blockers).Msg("Dish in use - we cannot remove it")
+		return &ConflictError{Message: "Dish is in use - Archive it or remove the references first", Conflicts: blockers}
+	}
+
+	tx := d.db.Begin()
+	defer tx.Rollback()
+
+	err = dishEndPromotions(tx, dishId)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Delete(&models.Dish{}, dishId).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("dishId", dishId).Msg("Failed to delete dish")
+		return err
+	}
+
+	return tx.Commit().Error
 }
 
 func (d *Database) DishDetails(dishId uint64) (models.Dish, error) {
 	var dish models.Dish
 	err := d.db.Preload("Allergens", func(db *gorm.DB) *gorm.DB {
 		return db.Order("allergens.name")
+	}).Preload("ExtraAllergens", func(db *gorm.DB) *gorm.DB {
+		return db.Order("allergens.name")
+	}).Preload("Traces", func(db *gorm.DB) *gorm.DB {
+		return db.Order("allergens.name")
 	}).Preload("Ingredients", func(db *gorm.DB) *gorm.DB {
 		return db.Order("ingredients.name")
 	}).Preload("Categories", func(db *gorm.DB) *gorm.DB {
-		return db.Order("categories.name")
-	}).Preload("Promotions").
-		First(&dish, dishId).Error
+		return db.Order("categories.position, categories.name")
+	}).Preload("Promotions").Preload("ModifierGroups", func(db *gorm.DB) *gorm.DB {
+		return db.Order("modifier_groups.position")
+	}).Preload("ModifierGroups.Modifiers", func(db *gorm.DB) *gorm.DB {
+		return db.Order("modifiers.name")
+	}).First(&dish, dishId).Error
 	return dish, err
 }
 
@@ -101,8 +191,8 @@ func (d *Database) DishFavourites(userId int64, limit uint64, offset uint64) ([]
 		err = d.db.Preload("Allergens", func(db *gorm.DB) *gorm.DB {
 			return db.Order("allergens.name")
 		}).Preload("Categories", func(db *gorm.DB) *gorm.DB {
-			return db.Order("categories.name")
-		}).Preload("Promotions").
+			return db.Order("categories.position, categories.name")
+		}).Preload("Promotions").Scopes(dishesActive).
 			Joins("RIGHT JOIN dish_likes ON dish_likes.dish_id = dishes.id").Where(`dish_likes.user_id = ?`, userId).Order("name").Limit(int(limit)).Offset(int(offset)).Find(&dishes).Error
 	}
 
@@ -111,8 +201,8 @@ func (d *Database) DishFavourites(userId int64, limit uint64, offset uint64) ([]
 		err = d.db.Preload("Allergens", func(db *gorm.DB) *gorm.DB {
 			return db.Order("allergens.name")
 		}).Preload("Categories", func(db *gorm.DB) *gorm.DB {
-			return db.Order("categories.name")
-		}).Preload("Promotions").
+			return db.Order("categories.position, categories.name")
+		}).Preload("Promotions").Scopes(dishesActive).
 			Order("likes desc").Limit(int(limit)).Offset(int(offset)).Find(&dishes).Error
 	}
 
@@ -164,13 +254,18 @@ func (d *Database) DishLike(userId uint64, dishId uint64) error {
 	return nil
 }
 
-func (d *Database) DishList(searchTerm string, limit uint64, offset uint64) ([]models.Dish, error) {
+func (d *Database) DishList(searchTerm string, language string, includeArchived bool, limit uint64, offset uint64) ([]models.Dish, error) {
 	var err error
 	var dishes []models.Dish
 
 	scope := d.db
+	if !includeArchived {
+		scope = scope.Scopes(dishesActive)
+	}
 	if len(searchTerm) > 0 {
-		scope = scope.Where("name ILIKE ?", fmt.Sprintf(`%%%s%%`, searchTerm))
+		// search in the default language and in the requested one
+		query, args := translationSearch("dishes", "name", language, searchTerm)
+		scope = scope.Where(query, args...)
 	}
 	err = scope.Preload("Promotions", func(db *gorm.DB) *gorm.DB {
 		return db.Order("promotions.start_time DESC")
@@ -179,7 +274,7 @@ func (d *Database) DishList(searchTerm string, limit uint64, offset uint64) ([]m
 	}).Preload("Allergens", func(db *gorm.DB) *gorm.DB {
 		return db.Order("allergens.name")
 	}).Preload("Categories", func(db *gorm.DB) *gorm.DB {
-		return db.Order("categories.name")
+		return db.Order("categories.position, categories.name")
 	}).Order("name").Limit(int(limit)).Offset(int(offset)).Find(&dishes).Error
 	if err != nil {
 		log.Error().Err(err).Uint64("limit", limit).Uint64("offset", offset).Msg("Failed to list dishes")
@@ -189,16 +284,21 @@ func (d *Database) DishList(searchTerm string, limit uint64, offset uint64) ([]m
 	return dishes, nil
 }
 
-func (d *Database) DishModify(dish models.Dish) (models.Dish, error) {
+func (d *Database) DishModify(dish models.Dish, userId uint64) (models.Dish, error) {
 	var err error
 
 	tx := d.db.Begin()
 	defer tx.Rollback()
 
+	// allergens are calculated from the ingredients, the ones sent are extra allergens
+	if dish.ExtraAllergens == nil {
+		dish.ExtraAllergens = dish.Allergens
+	}
+
 	// replace allergens and ingredients - Update adds new records, but doesn't delete old ones
-	err = tx.Model(&dish).Association("Allergens").Replace(dish.Allergens)
+	err = tx.Model(&dish).Association("ExtraAllergens").Replace(dish.ExtraAllergens)
 	if err != nil {
-		log.Error().Err(err).Interface("dish", dish).Msg("Failed to replace dish allergens")
+		log.Error().Err(err).Interface("dish", dish).Msg("Failed to replace dish extra allergens")
 		return dish, err
 	}
 
@@ -214,11 +314,25 @@ func (d *Database) DishModify(dish models.Dish) (models.Dish, error) {
 		return dish, err
 	}
 
-	err = tx.Updates(&dish).Error
+	// modifier groups are managed from their own endpoints, and the stock with DishStock
+	err = tx.Omit("ModifierGroups", "Allergens", "ExtraAllergens", "Traces", "Stock").Updates(&dish).Error
+	if err != nil {
+		return dish, err
+	}
+
+	err = dishUpdateAllergens(tx, []uint64{dish.ID})
 	if err != nil {
 		return dish, err
 	}
 
+	// Update doesn't update 0 - keep the current price
+	if dish.Cost > 0 {
+		err = d.dishPriceRecord(tx, dish.ID, dish.Cost, userId)
+		if err != nil {
+			return dish, err
+		}
+	}
+
 	err = tx.Commit().Error
 	if err != nil {
 		log.Error().Err(err).Interface("dish", dish).Msg("Failed to commit modify dish")
@@ -227,21 +341,92 @@ func (d *Database) DishModify(dish models.Dish) (models.Dish, error) {
 	return d.DishDetails(uint64(dish.ID))
 }
 
-func (d *Database) dishCurrentCost(dishId uint64) (float64, error) {
-	var err error
-	var dish models.Dish
-	var promotion models.Promotion
-
-	err = d.db.Select("cost").Where("dish_id = ? AND current_date BETWEEN start_time AND end_time", dishId).First(&promotion).Error
-	if err == nil {
-		// Dish has active Promotion
-		return promotion.Cost, nil
-	} else {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			// Dish doesn't have promotion
-			err = d.db.Select("cost").First(&dish, dishId).Error
-			return dish.Cost, err
+// Cost of the dish at the delivery time: effective price with the best running promotion
+// Portions of the dish left to order - nil removes the limit
+func (d *Database) DishStock(dishId uint64, stock *uint) (models.Dish, error) {
+	// map, so a zero or null stock is saved too
+	res := d.db.Model(&models.Dish{}).Where("id = ?", dishId).Updates(map[string]interface{}{"stock": stock})
+	if res.Error != nil {
+		log.Error().Err(res.Error).Uint64("dishId", dishId).Msg("Failed to update dish stock")
+		return models.Dish{}, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return models.Dish{}, gorm.ErrRecordNotFound
+	}
+
+	return d.DishDetails(dishId)
+}
+
+func (d *Database) dishCurrentCost(dishId uint64, delivery time.Time) (models.Money, error) {
+	_, cost, _, err := d.dishCostBreakdown(d.db, dishId, delivery)
+	return cost, err
+}
+
+// Effective price of the dish at the delivery time, its cost after promotions and the promotion applied (nil = none)
+func (d *Database) dishCostBreakdown(tx *gorm.DB, dishId uint64, delivery time.Time) (models.Money, models.Money, *models.Promotion, error) {
+	base, err := d.dishPriceAt(tx, dishId, delivery)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	promotions, err := d.promotionsActive(tx, dishId, delivery, models.PromotionPrice, models.PromotionPercentage, models.PromotionAmount)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	// promotions don't add up - the customer gets the cheapest one
+	cost := base
+	var applied *models.Promotion
+	for i := range promotions {
+		promoted := promotionUnitCost(promotions[i], base)
+		if promoted < cost {
+			cost = promoted
+			applied = &promotions[i]
 		}
 	}
-	return 0, err
+
+	return base, cost, applied, nil
+}
+
+// Pending orders and set menus using the dish
+func (d *Database) dishBlockers(dishId uint64) ([]models.Blocker, error) {
+	var blockers []models.Blocker
+
+	err := d.db.Raw(`SELECT DISTINCT 'order' AS entity, o.id, u.email AS name FROM orders o
+			JOIN users u ON u.id = o.user_id
+			JOIN order_lines ol ON ol.order_id = o.id AND ol.deleted_at IS NULL
+			LEFT JOIN order_line_choices c ON c.order_line_id = ol.id AND c.deleted_at IS NULL
+		WHERE (ol.dish_id = ? OR c.dish_id = ?) AND o.status NOT IN ('delivered', 'cancelled') AND o.deleted_at IS NULL
+		UNION
+		SELECT DISTINCT 'setmenu' AS entity, m.id, m.name FROM set_menus m
+			JOIN set_menu_courses mc ON mc.set_menu_id = m.id AND mc.deleted_at IS NULL
+			JOIN set_menu_course_dishes mcd ON mcd.set_menu_course_id = mc.id
+		WHERE mcd.dish_id = ? AND m.deleted_at IS NULL
+		ORDER BY entity, id`, dishId, dishId, dishId).Scan(&blockers).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("dishId", dishId).Msg("Failed to find dish references")
+	}
+
+	return blockers, err
+}
+
+// Ends the running promotions of the dish now, and removes the ones not started yet
+func dishEndPromotions(tx *gorm.DB, dishId uint64) error {
+	err := tx.Where("dish_id = ? AND start_time > ?", dishId, time.Now()).Delete(&models.Promotion{}).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("dishId", dishId).Msg("Failed to delete future promotions")
+		return err
+	}
+
+	err = tx.Model(&models.Promotion{}).Where("dish_id = ? AND end_time > ?", dishId, time.Now()).Update("end_time", time.Now()).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("dishId", dishId).Msg("Failed to end running promotions")
+	}
+
+	return err
+}
+
+// Dishes that can be ordered
+func dishesActive(db *gorm.DB) *gorm.DB {
+	return db.Where("dishes.archived = false")
 }
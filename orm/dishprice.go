@@ -0,0 +1,123 @@
+package orm
+
+import (
+	"errors"
+	"tfm_backend/models"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+// Copies the price effective now to the dish, so scheduled prices are shown when they start
+func (d *Database) DishPriceApply() error {
+	result := d.db.Exec(`UPDATE dishes SET cost = p.cost, updated_at = ? FROM (
+			SELECT DISTINCT ON (dish_id) dish_id, cost FROM dish_prices
+				WHERE effective_from <= ? AND deleted_at IS NULL ORDER BY dish_id, effective_from DESC
+		) p WHERE dishes.id = p.dish_id AND dishes.cost <> p.cost`, time.Now(), time.Now())
+	if result.Error != nil {
+		log.Error().Err(result.Error).Msg("Failed to apply dish prices")
+		return result.Error
+	}
+
+	if result.RowsAffected > 0 {
+		log.Info().Int64("dishes", result.RowsAffected).Msg("Applied scheduled dish prices")
+	}
+	return nil
+}
+
+// Removes a scheduled price - prices already effective are history and cannot be removed
+func (d *Database) DishPriceDelete(dishId uint64, priceId uint64) error {
+	result := d.db.Where("dish_id = ? AND effective_from > ?", dishId, time.Now()).Delete(&models.DishPrice{}, priceId)
+	if result.Error != nil {
+		log.Error().Err(result.Error).Uint64("dishId", dishId).Uint64("priceId", priceId).Msg("Failed to delete dish price")
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.New("only scheduled prices can be removed")
+	}
+	return nil
+}
+
+func (d *Database) DishPriceList(dishId uint64) ([]models.DishPrice, error) {
+	var prices []models.DishPrice
+	err := d.db.Preload("User", func(db *gorm.DB) *gorm.DB {
+		return db.Select("id", "name", "surname")
+	}).Where("dish_id = ?", dishId).Order("effective_from DESC").Find(&prices).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("dishId", dishId).Msg("Failed to list dish prices")
+	}
+	return prices, err
+}
+
+// Schedules a new price for the dish, effective immediately when no effective time is given
+func (d *Database) DishPriceSchedule(price models.DishPrice) (models.DishPrice, error) {
+	if price.EffectiveFrom.IsZero() {
+		price.EffectiveFrom = time.Now()
+	}
+	if price.EffectiveFrom.Before(time.Now().Add(-time.Minute)) {
+		return price, errors.New("prices cannot be scheduled in the past")
+	}
+	if price.Cost < 0 {
+		return price, errors.New("price cannot be negative")
+	}
+
+	err := d.db.First(&models.Dish{}, price.DishID).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("dishId", price.DishID).Msg("Failed to read dish for price")
+		return price, err
+	}
+
+	err = d.db.Omit("User").Create(&price).Error
+	if err != nil {
+		log.Error().Err(err).Interface("price", price).Msg("Failed to create dish price")
+		return price, err
+	}
+
+	// immediate prices are copied to the dish now
+	if !price.EffectiveFrom.After(time.Now()) {
+		err = d.DishPriceApply()
+	}
+
+	return price, err
+}
+
+// Price of the dish effective at the given time, without promotions
+func (d *Database) dishPriceAt(tx *gorm.DB, dishId uint64, at time.Time) (models.Money, error) {
+	var price models.DishPrice
+	err := tx.Select("cost").Where("dish_id = ? AND effective_from <= ?", dishId, at).
+		Order("effective_from DESC").First(&price).Error
+	if err == nil {
+		return price.Cost, nil
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// Dish without price history
+		var dish models.Dish
+		err = tx.Select("cost").First(&dish, dishId).Error
+		return dish.Cost, err
+	}
+
+	return 0, err
+}
+
+// Records a price change made by a dish modification, if the price is different from the effective one
+func (d *Database) dishPriceRecord(tx *gorm.DB, dishId uint64, cost models.Money, userId uint64) error {
+	var price models.DishPrice
+	err := tx.Select("cost").Where("dish_id = ? AND effective_from <= ?", dishId, time.Now()).
+		Order("effective_from DESC").First(&price).Error
+	if err == nil && price.Cost == cost {
+		return nil
+	}
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Error().Err(err).Uint64("dishId", dishId).Msg("Failed to read dish price")
+		return err
+	}
+
+	err = tx.Omit("User").Create(&models.DishPrice{DishID: dishId, Cost: cost, EffectiveFrom: time.Now(), UserID: userId}).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("dishId", dishId).Msg("Failed to record dish price")
+	}
+	return err
+}
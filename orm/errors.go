@@ -0,0 +1,26 @@
+package orm
+
+import "fmt"
+
+// Returned when an operation cannot be done because other objects depend on it or overlap with it
+type ConflictError struct {
+	Message   string
+	Conflicts interface{}
+}
+
+func (e *ConflictError) Error() string {
+	return e.Message
+}
+
+// Returned when the request breaks a rule of the business - the client has to change it
+type ValidationError struct {
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+func validationErrorf(format string, args ...interface{}) error {
+	return &ValidationError{Message: fmt.Sprintf(format, args...)}
+}
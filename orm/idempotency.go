@@ -0,0 +1,72 @@
+package orm
+
+import (
+	"tfm_backend/models"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm/clause"
+)
+
+// How long the response of a request is kept to answer its retries
+const idempotencyWindow = 24 * time.Hour
+
+// Reserves the key for the request. When the key was already used the stored record is returned
+// instead, and started is false.
+func (d *Database) IdempotencyKeyStart(userId uint64, key string, requestHash string) (models.IdempotencyKey, bool, error) {
+	var err error
+
+	// keys of old requests can be used again
+	err = d.db.Where("user_id = ? AND key = ? AND created_at < ?", userId, key, time.Now().Add(-idempotencyWindow)).
+		Delete(&models.IdempotencyKey{}).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", userId).Str("key", key).Msg("Failed to delete expired Idempotency key")
+		return models.IdempotencyKey{}, false, err
+	}
+
+	record := models.IdempotencyKey{UserID: userId, Key: key, RequestHash: requestHash}
+	res := d.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&record)
+	if res.Error != nil {
+		log.Error().Err(res.Error).Uint64("userId", userId).Str("key", key).Msg("Failed to create Idempotency key")
+		return record, false, res.Error
+	}
+	if res.RowsAffected == 1 {
+		return record, true, nil
+	}
+
+	err = d.db.Where("user_id = ? AND key = ?", userId, key).First(&record).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", userId).Str("key", key).Msg("Failed to read Idempotency key")
+	}
+	return record, false, err
+}
+
+// Stores the response of the request, to be returned to its retries
+func (d *Database) IdempotencyKeyFinish(id uint64, statusCode int, response string) error {
+	err := d.db.Model(&models.IdempotencyKey{}).Where("id = ?", id).
+		Updates(map[string]interface{}{"status_code": statusCode, "response": response}).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("id", id).Msg("Failed to save Idempotency key response")
+	}
+	return err
+}
+
+// Frees the key when the request failed unexpectedly, so it can be retried
+func (d *Database) IdempotencyKeyRelease(id uint64) error {
+	err := d.db.Delete(&models.IdempotencyKey{}, id).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("id", id).Msg("Failed to release Idempotency key")
+	}
+	return err
+}
+
+func (d *Database) IdempotencyKeysPurge() error {
+	res := d.db.Where("created_at < ?", time.Now().Add(-idempotencyWindow)).Delete(&models.IdempotencyKey{})
+	if res.Error != nil {
+		log.Error().Err(res.Error).Msg("Failed to purge Idempotency keys")
+		return res.Error
+	}
+
+	log.Debug().Int64("deleted", res.RowsAffected).Msg("Purged expired Idempotency keys")
+	return nil
+}
@@ -24,7 +24,11 @@ func (d *Database) IngredientCreate(ingredient models.Ingredient) (models.Ingred
 
 func (d *Database) IngredientDetails(ingredientId uint64) (models.Ingredient, error) {
 	var ingredient models.Ingredient
-	err := d.db.First(&ingredient, ingredientId).Error
+	err := d.db.Preload("Allergens", func(db *gorm.DB) *gorm.DB {
+		return db.Order("allergens.name")
+	}).Preload("Traces", func(db *gorm.DB) *gorm.DB {
+		return db.Order("allergens.name")
+	}).First(&ingredient, ingredientId).Error
 	if err != nil {
 		log.Error().Err(err).Uint64("ingredientId", ingredientId).Msg("Failed to detail Ingredient")
 		return models.Ingredient{}, err
@@ -63,8 +67,8 @@ func (d *Database) IngredientDishes(ingredientId uint64, limit uint64, offset ui
 	err = d.db.Preload("Ingredients", func(db *gorm.DB) *gorm.DB {
 		return db.Order("ingredients.name")
 	}).Preload("Categories", func(db *gorm.DB) *gorm.DB {
-		return db.Order("categories.name")
-	}).Joins("RIGHT JOIN dish_categories ON dish_categories.dish_id = dishes.id").
+		return db.Order("categories.position, categories.name")
+	}).Scopes(dishesActive).Joins("RIGHT JOIN dish_categories ON dish_categories.dish_id = dishes.id").
 		Where(`dish_categories.ingredient_id = ?`, ingredientId).
 		Order("name").Limit(int(limit)).Offset(int(offset)).Find(&dishes).Error
 	if err != nil {
@@ -90,7 +94,11 @@ func (d *Database) IngredientList() ([]models.Ingredient, error) {
 	var err error
 	var categories []models.Ingredient
 
-	err = d.db.Order("name").Find(&categories).Error
+	err = d.db.Preload("Allergens", func(db *gorm.DB) *gorm.DB {
+		return db.Order("allergens.name")
+	}).Preload("Traces", func(db *gorm.DB) *gorm.DB {
+		return db.Order("allergens.name")
+	}).Order("name").Find(&categories).Error
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to list Ingredient")
 		return categories, err
@@ -100,11 +108,56 @@ func (d *Database) IngredientList() ([]models.Ingredient, error) {
 }
 
 func (d *Database) IngredientModify(ingredient models.Ingredient) (models.Ingredient, error) {
-	err := d.db.Save(&ingredient).Error
+	var err error
+
+	tx := d.db.Begin()
+	defer tx.Rollback()
+
+	err = tx.Omit("Allergens", "Traces").Save(&ingredient).Error
 	if err != nil {
 		log.Error().Err(err).Interface("ingredient", ingredient).Msg("Failed to update Ingredient")
 		return models.Ingredient{}, err
 	}
 
-	return ingredient, nil
+	// replace allergens and traces - Update adds new records, but doesn't delete old ones
+	for association, allergens := range ingredientAllergenLists(ingredient) {
+		err = tx.Model(&ingredient).Association(association).Replace(allergens)
+		if err != nil {
+			log.Error().Err(err).Interface("ingredient", ingredient).Str("association", association).Msg("Failed to replace Ingredient allergens")
+			return models.Ingredient{}, err
+		}
+	}
+
+	// dishes with this ingredient have new allergens
+	var dishIds []uint64
+	err = tx.Raw(`SELECT dish_id FROM dish_ingredients WHERE ingredient_id = ?`, ingredient.ID).Scan(&dishIds).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("ingredientId", ingredient.ID).Msg("Failed to find Dish with Ingredient")
+		return models.Ingredient{}, err
+	}
+
+	err = dishUpdateAllergens(tx, dishIds)
+	if err != nil {
+		return models.Ingredient{}, err
+	}
+
+	err = tx.Commit().Error
+	if err != nil {
+		log.Error().Err(err).Interface("ingredient", ingredient).Msg("Failed to commit modify Ingredient")
+		return models.Ingredient{}, err
+	}
+
+	return d.IngredientDetails(ingredient.ID)
+}
+
+// Allergen lists sent with the ingredient - a missing list (nil) keeps the current allergens, an empty one clears them
+func ingredientAllergenLists(ingredient models.Ingredient) map[string][]models.Allergen {
+	lists := make(map[string][]models.Allergen)
+	if ingredient.Allergens != nil {
+		lists["Allergens"] = ingredient.Allergens
+	}
+	if ingredient.Traces != nil {
+		lists["Traces"] = ingredient.Traces
+	}
+	return lists
 }
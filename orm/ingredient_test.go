@@ -0,0 +1,40 @@
+package orm
+
+import (
+	"encoding/json"
+	"testing"
+	"tfm_backend/models"
+)
+
+func TestIngredientAllergenLists(t *testing.T) {
+	tests := []struct {
+		body string
+		want map[string]int // association -> allergens replaced
+	}{
+		{`{"name": "gambas"}`, map[string]int{}},
+		{`{"name": "gambas", "allergens": [{"id": 2}]}`, map[string]int{"Allergens": 1}},
+		{`{"name": "gambas", "traces": [{"id": 3}, {"id": 4}]}`, map[string]int{"Traces": 2}},
+		{`{"name": "gambas", "allergens": [], "traces": null}`, map[string]int{"Allergens": 0}},
+		{`{"name": "gambas", "allergens": [], "traces": []}`, map[string]int{"Allergens": 0, "Traces": 0}},
+	}
+
+	for _, test := range tests {
+		var ingredient models.Ingredient
+		err := json.Unmarshal([]byte(test.body), &ingredient)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		lists := ingredientAllergenLists(ingredient)
+		if len(lists) != len(test.want) {
+			t.Errorf("%s: replaces %v, want %v", test.body, lists, test.want)
+			continue
+		}
+		for association, count := range test.want {
+			allergens, ok := lists[association]
+			if !ok || len(allergens) != count {
+				t.Errorf("%s: %s replaced with %v, want %d allergens", test.body, association, allergens, count)
+			}
+		}
+	}
+}
@@ -0,0 +1,329 @@
+package orm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"tfm_backend/models"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Series of the document numbers, each one restarts every year
+const (
+	invoiceSeriesReceipt = "REC"
+	invoiceSeriesMonthly = "INV"
+)
+
+// Receipt of a delivered order, issued the first time it is asked for (userId -1 = administrator)
+func (d *Database) InvoiceReceipt(userId int64, orderId uint64) (models.Invoice, error) {
+	order, err := d.OrderDetails(userId, orderId)
+	if err != nil {
+		return models.Invoice{}, err
+	}
+	if order.Status != models.OrderDelivered {
+		return models.Invoice{}, errors.New("receipts are issued once the order is delivered")
+	}
+
+	subject := models.Invoice{Type: models.InvoiceReceipt, OrderID: order.ID, UserID: order.UserID}
+	return d.invoiceIssue(subject, invoiceSeriesReceipt, func(tx *gorm.DB, content *models.InvoiceContent) error {
+		var err error
+		content.Period = order.Delivery.Format("2006-01-02")
+		content.Customer, err = invoiceUser(tx, order.UserID)
+		if err != nil {
+			return err
+		}
+
+		for _, line := range order.OrderLines {
+			description := line.Name
+			for _, modifier := range line.Modifiers {
+				description += ", " + modifier.Name
+			}
+			// promoted lines are printed at the regular price, with the promotion below
+			regular := line.CostUnit + line.PromotionDiscount
+			content.Lines = append(content.Lines, models.InvoiceLine{Description: description, Quantity: line.Quantity,
+				UnitPrice: regular, Amount: regular.Times(line.Quantity)})
+			if line.PromotionDiscount > 0 {
+				content.Lines = append(content.Lines, models.InvoiceLine{Description: "  " + line.Promotion, Amount: -line.PromotionDiscount.Times(line.Quantity)})
+			}
+		}
+		for _, discount := range order.Discounts {
+			content.Adjustments = append(content.Adjustments, models.InvoiceLine{Description: discount.Name, Amount: -discount.Amount})
+		}
+		if order.CouponDiscount > 0 {
+			content.Adjustments = append(content.Adjustments, models.InvoiceLine{Description: "Coupon " + order.CouponCode, Amount: -order.CouponDiscount})
+		}
+
+		content.Total = order.CostTotal
+		if order.Subvention > 0 {
+			content.Settlement = append(content.Settlement, models.InvoiceLine{Description: strings.TrimSpace("Subvention " + order.SubventionRule), Amount: order.Subvention})
+		}
+		if order.CostToPay > 0 {
+			content.Settlement = append(content.Settlement, models.InvoiceLine{Description: invoicePayment(order), Amount: order.CostToPay})
+		}
+		return nil
+	})
+}
+
+// Invoice of the orders delivered to the user in the month, once the month has ended.
+// Orders of company employees are invoiced to the company.
+func (d *Database) InvoiceMonthlyUser(userId uint64, month time.Time) (models.Invoice, error) {
+	from, err := invoiceMonth(month)
+	if err != nil {
+		return models.Invoice{}, err
+	}
+
+	subject := models.Invoice{Type: models.InvoiceMonthly, UserID: userId, Month: from}
+	return d.invoiceIssue(subject, invoiceSeriesMonthly, func(tx *gorm.DB, content *models.InvoiceContent) error {
+		var err error
+		content.Period = from.Format("2006-01")
+		content.Customer, err = invoiceUser(tx, userId)
+		if err != nil {
+			return err
+		}
+
+		customer := tx.Where("user_id = ? AND user_id NOT IN (?)", userId, tx.Model(&models.User{}).Select("id").Where("company_id > 0"))
+		return invoiceOrders(customer, from, content)
+	})
+}
+
+// Invoice of the orders delivered to the employees of the company in the month, once the month has ended.
+// The company pays the subventions, and the meal wallets that it deducts from payroll.
+func (d *Database) InvoiceMonthlyCompany(companyId uint64, month time.Time) (models.Invoice, error) {
+	from, err := invoiceMonth(month)
+	if err != nil {
+		return models.Invoice{}, err
+	}
+
+	company, err := d.CompanyDetails(companyId)
+	if err != nil {
+		return models.Invoice{}, err
+	}
+
+	subject := models.Invoice{Type: models.InvoiceMonthly, CompanyID: companyId, Month: from}
+	return d.invoiceIssue(subject, invoiceSeriesMonthly, func(tx *gorm.DB, content *models.InvoiceContent) error {
+		content.Period = from.Format("2006-01")
+		content.Customer = models.InvoiceParty{Name: company.Name, TaxID: company.TaxID}
+
+		employees := tx.Where("user_id IN (?)", tx.Model(&models.User{}).Select("id").Where("company_id = ?", companyId))
+		return invoiceOrders(employees, from, content)
+	})
+}
+
+// Issued document - only its customer or an administrator (userId -1) can get it
+func (d *Database) InvoiceDetails(userId int64, invoiceId uint64) (models.Invoice, error) {
+	var invoice models.Invoice
+	err := d.db.First(&invoice, invoiceId).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("invoiceId", invoiceId).Msg("Failed to read Invoice")
+		return invoice, err
+	}
+	if userId != -1 && invoice.UserID != uint64(userId) {
+		return models.Invoice{}, errors.New("Invoice doesn't belong to this User")
+	}
+
+	return invoice, nil
+}
+
+// Documents of the user, or of the company when companyId > 0 - without their content
+func (d *Database) InvoiceList(userId uint64, companyId uint64, limit uint64, offset uint64) ([]models.Invoice, error) {
+	var invoices []models.Invoice
+	scope := d.db.Omit("content")
+	if companyId > 0 {
+		scope = scope.Where("company_id = ?", companyId)
+	} else {
+		scope = scope.Where("user_id = ?", userId)
+	}
+	err := scope.Order("id DESC").Limit(int(limit)).Offset(int(offset)).Find(&invoices).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", userId).Uint64("companyId", companyId).Msg("Failed to list Invoices")
+	}
+	return invoices, err
+}
+
+// Issues the document once. The number is taken from the counter of the series in the same transaction,
+// so a failure gives it back and numbers have no gaps. The content is stored, later changes don't alter it.
+func (d *Database) invoiceIssue(subject models.Invoice, series string, fill func(tx *gorm.DB, content *models.InvoiceContent) error) (models.Invoice, error) {
+	issued := time.Now()
+
+	tx := d.db.Begin()
+	defer tx.Rollback()
+
+	// documents are issued one at a time for each series
+	counter, err := invoiceCounterLock(tx, series, issued.Year())
+	if err != nil {
+		return models.Invoice{}, err
+	}
+
+	var invoice models.Invoice
+	err = tx.Where("type = ? AND order_id = ? AND user_id = ? AND company_id = ? AND month = ?",
+		subject.Type, subject.OrderID, subject.UserID, subject.CompanyID, subject.Month).Limit(1).Find(&invoice).Error
+	if err != nil {
+		log.Error().Err(err).Interface("subject", subject).Msg("Failed to read issued Invoice")
+		return models.Invoice{}, err
+	}
+	if invoice.ID > 0 {
+		return invoice, nil
+	}
+
+	var config models.Configuration
+	err = tx.Select("tax_rate", "business_name", "business_tax_id", "business_address").First(&config).Error
+	if err != nil {
+		log.Error().Err(err).Msg(errMsgReadConfig)
+		return models.Invoice{}, err
+	}
+
+	counter.Last++
+	content := models.InvoiceContent{Type: subject.Type, Number: fmt.Sprintf("%s-%d-%06d", series, counter.Year, counter.Last), Issued: issued,
+		Issuer: models.InvoiceParty{Name: config.BusinessName, TaxID: config.BusinessTaxID, Address: invoiceAddress(strings.Split(config.BusinessAddress, "\n")...)}}
+	err = fill(tx, &content)
+	if err != nil {
+		return models.Invoice{}, err
+	}
+	content.Taxes = invoiceTaxes(content.Total, config.TaxRate)
+
+	data, err := json.Marshal(content)
+	if err != nil {
+		log.Error().Err(err).Str("number", content.Number).Msg("Failed to encode Invoice content")
+		return models.Invoice{}, err
+	}
+	subject.Number, subject.Total, subject.Content = content.Number, content.Total, string(data)
+
+	err = tx.Create(&subject).Error
+	if err != nil {
+		log.Error().Err(err).Str("number", subject.Number).Msg("Failed to create Invoice")
+		return models.Invoice{}, err
+	}
+
+	err = tx.Model(&counter).Update("last", counter.Last).Error
+	if err != nil {
+		log.Error().Err(err).Str("series", series).Msg("Failed to update Invoice counter")
+		return models.Invoice{}, err
+	}
+
+	err = tx.Commit().Error
+	if err != nil {
+		log.Error().Err(err).Str("number", subject.Number).Msg(errMsgTxCommit)
+		return models.Invoice{}, err
+	}
+
+	return subject, nil
+}
+
+// Reads the counter for update, creating it the first time in the year
+func invoiceCounterLock(tx *gorm.DB, series string, year int) (models.InvoiceCounter, error) {
+	var counter models.InvoiceCounter
+	err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.InvoiceCounter{Series: series, Year: year}).Error
+	if err == nil {
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("series = ? AND year = ?", series, year).First(&counter).Error
+	}
+	if err != nil {
+		log.Error().Err(err).Str("series", series).Int("year", year).Msg("Failed to lock Invoice counter")
+	}
+	return counter, err
+}
+
+// One line for every order delivered in the month, with the subventions and the rest by payment as the settlement
+func invoiceOrders(scope *gorm.DB, from time.Time, content *models.InvoiceContent) error {
+	var orders []models.Order
+	err := scope.Preload("User", func(db *gorm.DB) *gorm.DB {
+		return db.Select("id", "name", "surname")
+	}).Where("status = ? AND delivery >= ? AND delivery < ?", models.OrderDelivered, from, from.AddDate(0, 1, 0)).
+		Order("delivery, id").Find(&orders).Error
+	if err != nil {
+		log.Error().Err(err).Time("month", from).Msg("Failed to read orders to invoice")
+		return err
+	}
+	if len(orders) == 0 {
+		return errors.New("there are no delivered orders to invoice in the month")
+	}
+
+	var subvention models.Money
+	var payments []string
+	paid := make(map[string]models.Money)
+	for _, order := range orders {
+		content.Lines = append(content.Lines, models.InvoiceLine{
+			Description: fmt.Sprintf("Order %d, %s, %s %s", order.ID, order.Delivery.Format("2006-01-02"), order.User.Name, order.User.Surname),
+			Amount:      order.CostTotal,
+		})
+		content.Total += order.CostTotal
+		subvention += order.Subvention
+		if order.CostToPay > 0 {
+			payment := invoicePayment(order)
+			if _, found := paid[payment]; !found {
+				payments = append(payments, payment)
+			}
+			paid[payment] += order.CostToPay
+		}
+	}
+
+	if subvention > 0 {
+		content.Settlement = append(content.Settlement, models.InvoiceLine{Description: "Subventions", Amount: subvention})
+	}
+	for _, payment := range payments {
+		content.Settlement = append(content.Settlement, models.InvoiceLine{Description: payment, Amount: paid[payment]})
+	}
+	return nil
+}
+
+func invoiceUser(tx *gorm.DB, userId uint64) (models.InvoiceParty, error) {
+	var user models.User
+	err := tx.First(&user, userId).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", userId).Msg("Failed to read User to invoice")
+		return models.InvoiceParty{}, err
+	}
+
+	return models.InvoiceParty{Name: strings.TrimSpace(user.Name + " " + user.Surname), Email: user.Email,
+		Address: invoiceAddress(user.Address1, user.Address2, user.Address3, strings.TrimSpace(user.PostalCode+" "+user.City))}, nil
+}
+
+// Address lines, without the empty ones
+func invoiceAddress(lines ...string) []string {
+	var address []string
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if len(line) > 0 {
+			address = append(address, line)
+		}
+	}
+	return address
+}
+
+// How the cost to pay of the order was paid, or that it is still pending
+func invoicePayment(order models.Order) string {
+	switch {
+	case order.PaymentMethod == models.PaymentMethodWallet:
+		return "Meal wallet, deducted from payroll"
+	case order.PaymentStatus == models.PaymentCaptured:
+		return "Card"
+	case order.PaymentStatus == models.PaymentAuthorized:
+		return "Card, pending capture"
+	case order.PaymentStatus == models.PaymentRefunded:
+		return "Card, refunded"
+	case order.PaymentStatus == models.PaymentFailed:
+		return "Card payment failed, pending payment"
+	default:
+		return "Pending payment"
+	}
+}
+
+// Taxes included in the total - prices have the VAT in them
+func invoiceTaxes(total models.Money, rate float64) []models.InvoiceTax {
+	base := models.Money(math.Round(float64(total) * 100 / (100 + rate)))
+	return []models.InvoiceTax{{Rate: rate, Base: base, Tax: total - base}}
+}
+
+// First day of the month, which must have ended
+func invoiceMonth(month time.Time) (time.Time, error) {
+	from := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.Now().Location())
+	if time.Now().Before(from.AddDate(0, 1, 0)) {
+		return from, errors.New("monthly invoices are issued once the month has ended")
+	}
+	return from, nil
+}
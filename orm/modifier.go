@@ -0,0 +1,175 @@
+package orm
+
+import (
+	"slices"
+	"tfm_backend/models"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+func (d *Database) ModifierGroupCreate(group models.ModifierGroup) (models.ModifierGroup, error) {
+	err := modifierGroupValidate(d.db, group)
+	if err != nil {
+		return group, err
+	}
+
+	err = d.db.Create(&group).Error
+	if err != nil {
+		log.Error().Err(err).Interface("group", group).Msg("Failed to create ModifierGroup")
+		return group, err
+	}
+
+	return d.ModifierGroupDetails(group.DishID, group.ID)
+}
+
+func (d *Database) ModifierGroupDelete(dishId uint64, groupId uint64) error {
+	return d.db.Where("dish_id = ?", dishId).Delete(&models.ModifierGroup{}, groupId).Error
+}
+
+func (d *Database) ModifierGroupDetails(dishId uint64, groupId uint64) (models.ModifierGroup, error) {
+	var group models.ModifierGroup
+	err := d.db.Preload("Modifiers", func(db *gorm.DB) *gorm.DB {
+		return db.Order("modifiers.name")
+	}).Where("dish_id = ?", dishId).First(&group, groupId).Error
+	return group, err
+}
+
+func (d *Database) ModifierGroupModify(group models.ModifierGroup) (models.ModifierGroup, error) {
+	var err error
+
+	tx := d.db.Begin()
+	defer tx.Rollback()
+
+	// the group must belong to the dish
+	err = tx.Where("dish_id = ?", group.DishID).First(&models.ModifierGroup{}, group.ID).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("dishId", group.DishID).Uint64("groupId", group.ID).Msg("Failed to read ModifierGroup of Dish")
+		return group, err
+	}
+
+	err = modifierGroupValidate(tx, group)
+	if err != nil {
+		return group, err
+	}
+
+	err = tx.Omit("Modifiers").Where("dish_id = ?", group.DishID).Updates(&group).Error
+	if err != nil {
+		log.Error().Err(err).Interface("group", group).Msg("Failed to update ModifierGroup")
+		return group, err
+	}
+
+	// Update doesn't update false
+	err = tx.Model(&group).Updates(map[string]interface{}{"multiple": group.Multiple, "required": group.Required}).Error
+	if err != nil {
+		log.Error().Err(err).Interface("group", group).Msg("Failed to update ModifierGroup flags")
+		return group, err
+	}
+
+	// replace modifiers - Update adds new records, but doesn't delete old ones.
+	// Only modifiers of this group keep their id, any other id is created as a new modifier
+	var existing []uint64
+	err = tx.Model(&models.Modifier{}).Where("modifier_group_id = ?", group.ID).Pluck("id", &existing).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("groupId", group.ID).Msg("Failed to read Modifiers of ModifierGroup")
+		return group, err
+	}
+
+	var keep []uint64
+	for i := range group.Modifiers {
+		if !slices.Contains(existing, group.Modifiers[i].ID) {
+			group.Modifiers[i].ID = 0
+		}
+		group.Modifiers[i].ModifierGroupID = group.ID
+		err = tx.Save(&group.Modifiers[i]).Error
+		if err != nil {
+			log.Error().Err(err).Interface("modifier", group.Modifiers[i]).Msg("Failed to save Modifier")
+			return group, err
+		}
+		keep = append(keep, group.Modifiers[i].ID)
+	}
+
+	scope := tx.Where("modifier_group_id = ?", group.ID)
+	if len(keep) > 0 {
+		scope = scope.Where("id NOT IN ?", keep)
+	}
+	err = scope.Delete(&models.Modifier{}).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("groupId", group.ID).Msg("Failed to delete old Modifiers")
+		return group, err
+	}
+
+	err = tx.Commit().Error
+	if err != nil {
+		log.Error().Err(err).Interface("group", group).Msg("Failed to commit modify ModifierGroup")
+		return group, err
+	}
+
+	return d.ModifierGroupDetails(group.DishID, group.ID)
+}
+
+// Modifiers removing an ingredient can only remove ingredients of the dish
+func modifierGroupValidate(tx *gorm.DB, group models.ModifierGroup) error {
+	for _, modifier := range group.Modifiers {
+		if modifier.IngredientID == 0 {
+			continue
+		}
+
+		var count int64
+		err := tx.Table("dish_ingredients").Where("dish_id = ? AND ingredient_id = ?", group.DishID, modifier.IngredientID).Count(&count).Error
+		if err != nil {
+			log.Error().Err(err).Uint64("dishId", group.DishID).Uint64("ingredientId", modifier.IngredientID).Msg("Failed to read Dish ingredient")
+			return err
+		}
+		if count == 0 {
+			return validationErrorf("modifier %s removes ingredient %d, which is not an ingredient of dish %d", modifier.Name, modifier.IngredientID, group.DishID)
+		}
+	}
+
+	return nil
+}
+
+// Validates the modifiers chosen for a dish line, snapshots them and returns the cost they add to the dish
+func (d *Database) modifierLine(tx *gorm.DB, line models.OrderLine) ([]models.OrderLineModifier, models.Money, error) {
+	var err error
+	var groups []models.ModifierGroup
+
+	err = tx.Preload("Modifiers").Where("dish_id = ?", line.DishID).Find(&groups).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("dishId", line.DishID).Msg("Failed to read dish modifier groups")
+		return nil, 0, err
+	}
+
+	var chosen []models.OrderLineModifier
+	var costDelta models.Money = 0
+	used := make(map[uint64]bool)
+	for _, group := range groups {
+		count := 0
+		for _, modifier := range group.Modifiers {
+			for _, selected := range line.Modifiers {
+				if selected.ModifierID != modifier.ID || used[modifier.ID] {
+					continue
+				}
+				used[modifier.ID] = true
+				count++
+				costDelta += modifier.CostDelta
+				chosen = append(chosen, models.OrderLineModifier{ModifierID: modifier.ID, Name: modifier.Name, CostDelta: modifier.CostDelta})
+			}
+		}
+
+		if group.Required && count == 0 {
+			return nil, 0, validationErrorf("a choice of %s is required", group.Name)
+		}
+		if !group.Multiple && count > 1 {
+			return nil, 0, validationErrorf("only one choice of %s is allowed", group.Name)
+		}
+	}
+
+	for _, selected := range line.Modifiers {
+		if !used[selected.ModifierID] {
+			return nil, 0, validationErrorf("modifier %d cannot be applied to dish %d", selected.ModifierID, line.DishID)
+		}
+	}
+
+	return chosen, costDelta, nil
+}
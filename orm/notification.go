@@ -0,0 +1,42 @@
+package orm
+
+import (
+	"tfm_backend/models"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+func (d *Database) NotificationList(userId uint64, unreadOnly bool, limit uint64, offset uint64) ([]models.Notification, error) {
+	var notifications []models.Notification
+	scope := d.db.Where("user_id = ?", userId)
+	if unreadOnly {
+		scope = scope.Where("read = false")
+	}
+	err := scope.Order("created_at DESC").Limit(int(limit)).Offset(int(offset)).Find(&notifications).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", userId).Msg("Failed to list Notifications")
+	}
+	return notifications, err
+}
+
+func (d *Database) NotificationRead(userId uint64, notificationId uint64) error {
+	res := d.db.Model(&models.Notification{}).Where("id = ? AND user_id = ?", notificationId, userId).Update("read", true)
+	if res.Error != nil {
+		log.Error().Err(res.Error).Uint64("notificationId", notificationId).Msg("Failed to read Notification")
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (d *Database) notificationCreate(tx *gorm.DB, userId uint64, message string) error {
+	err := tx.Create(&models.Notification{UserID: userId, Message: message}).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", userId).Str("message", message).Msg("Failed to create Notification")
+	}
+	return err
+}
@@ -2,16 +2,19 @@ package orm
 
 import (
 	"errors"
+	"fmt"
+	"sort"
 	"tfm_backend/models"
 	"time"
 
 	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 func (d *Database) OrderCount(fromDate time.Time, toDate time.Time) ([]models.CountOrders, error) {
 	var counts []models.CountOrders
-	var err error = d.db.Model(&models.Order{}).Select("TO_CHAR(created_at::date, 'yyyy-mm-dd') AS day, count(*)").
+	var err error = d.db.Model(&models.Order{}).Select("TO_CHAR(created_at::date, 'yyyy-mm-dd') AS day, count(*), count(*) FILTER (WHERE status = 'cancelled') AS cancelled").
 		Where("created_at::date >= ? AND created_at::date <= ?", fromDate, toDate).
 		Group("created_at::date").Order("created_at::date DESC").Find(&counts).Error
 	return counts, err
@@ -20,8 +23,8 @@ func (d *Database) OrderCount(fromDate time.Time, toDate time.Time) ([]models.Co
 func (d *Database) OrderCreate(order models.Order) (models.Order, error) {
 	var err error
 
-	// when we would deliver today
-	order.Delivery, err = d.configTodayDelivery()
+	// when we would deliver - today, or the business day the order is placed for
+	order.Delivery, err = d.configDelivery(order.Delivery)
 	if err != nil {
 		return models.Order{}, err
 	}
@@ -33,37 +36,42 @@ func (d *Database) OrderCreate(order models.Order) (models.Order, error) {
 		return models.Order{}, err
 	}
 
-	// Overwrite order line prices with dish prices (tampering protection)
-	var dish models.Dish
-	var costUnit float64
-	for i, line := range order.OrderLines {
-		err = d.db.Select("name").First(&dish, line.DishID).Error
+	order.Status = models.OrderPlaced
+	order.PaymentStatus = models.PaymentUnpaid
+	order.StatusHistory = []models.OrderStatusChange{{Status: models.OrderPlaced, UserID: order.UserID}}
+
+	// Transaction block
+	{
+		tx := d.db.Begin()
+		defer tx.Rollback()
+
+		// orders of the user are placed one at a time - only the first order of the day gets the subvention
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&models.User{}, order.UserID).Error
 		if err != nil {
-			log.Error().Err(err).Interface("line", line).Msg("Failed to read dish from order line")
+			log.Error().Err(err).Uint64("userId", order.UserID).Msg("Failed to lock user to create order")
 			return models.Order{}, err
 		}
-		costUnit, err = d.dishCurrentCost(line.DishID)
+
+		// Overwrite order line prices with dish and set menu prices (tampering protection), and take the portions from the stock
+		for i, line := range order.OrderLines {
+			order.OrderLines[i], err = d.orderLinePrice(tx, line, order.Delivery)
+			if err != nil {
+				return models.Order{}, err
+			}
+
+			err = orderLineStockTake(tx, order.OrderLines[i], int(order.OrderLines[i].Quantity))
+			if err != nil {
+				return models.Order{}, err
+			}
+		}
+
+		// calculate order total
+		order, err = d.orderCalculateCost(tx, order)
 		if err != nil {
-			log.Error().Err(err).Uint64("dishId", line.DishID).Msg("Failed to read cost from dish")
+			log.Error().Err(err).Interface("order", order).Msg("Failed to calculate cost order")
 			return models.Order{}, err
 		}
 
-		order.OrderLines[i].CostUnit = costUnit
-		order.OrderLines[i].Name = dish.Name
-	}
-
-	// calculate order total
-	order, err = d.orderCalculateCost(order)
-	if err != nil {
-		log.Error().Err(err).Interface("order", order).Msg("Failed to calculate cost order")
-		return models.Order{}, err
-	}
-
-	// Transaction block
-	{
-		tx := d.db.Begin()
-		defer tx.Rollback()
-
 		// create order and lines
 		err = tx.Create(&order).Error
 		if err != nil {
@@ -81,21 +89,6 @@ func (d *Database) OrderCreate(order models.Order) (models.Order, error) {
 	return order, err
 }
 
-func (d *Database) OrderDelete(userId uint64, orderId uint64) error {
-	// Only the owner of the order can delete it
-	canProceed, deliveryTime, err := d.orderOwnedByUser(userId, orderId)
-	if canProceed {
-		return d.db.Delete(&models.Order{}, orderId).Error
-	}
-
-	err = d.configChangesAllowed(deliveryTime)
-	if err != nil {
-		return err
-	}
-
-	return err
-}
-
 func (d *Database) OrderDetails(userId int64, orderId uint64) (models.Order, error) {
 	var err error
 	var canProceed bool
@@ -114,39 +107,89 @@ func (d *Database) OrderDetails(userId int64, orderId uint64) (models.Order, err
 
 	err = d.db.Preload("OrderLines", func(db *gorm.DB) *gorm.DB {
 		return db.Order("order_lines.name")
+	}).Preload("OrderLines.Choices").Preload("OrderLines.Modifiers").Preload("Discounts").Preload("StatusHistory", func(db *gorm.DB) *gorm.DB {
+		return db.Order("order_status_changes.created_at")
 	}).First(&order, orderId).Error
 	return order, err
 }
 
-func (d *Database) OrderList(userId int64, day string, limit uint64, offset uint64) ([]models.Order, error) {
+func (d *Database) OrderList(userId int64, day string, deliveryDay string, statuses []string, limit uint64, offset uint64) ([]models.Order, error) {
 	var orders []models.Order
 
-	queryDb := d.db.Preload("OrderLines").Preload("User", func(db *gorm.DB) *gorm.DB {
+	queryDb := d.db.Preload("OrderLines").Preload("OrderLines.Choices").Preload("OrderLines.Modifiers").Preload("Discounts").Preload("User", func(db *gorm.DB) *gorm.DB {
 		return db.Select("id", "name", "surname")
 	})
 
 	if len(day) > 0 {
 		queryDb = queryDb.Where("date(created_at) = ?", day)
 	}
+	if len(deliveryDay) > 0 {
+		queryDb = queryDb.Where("date(delivery) = ?", deliveryDay)
+	}
 	if userId > 0 {
 		queryDb = queryDb.Where("user_id = ?", userId)
 	}
+	if len(statuses) > 0 {
+		queryDb = queryDb.Where("status IN ?", statuses)
+	}
 
 	err := queryDb.Order("created_at DESC").Limit(int(limit)).Offset(int(offset)).Find(&orders).Error
 	return orders, err
 }
 
+func (d *Database) OrderKitchen(day string) ([]models.CountKitchenDishes, error) {
+	var dishes []models.CountKitchenDishes
+
+	// single dishes and dishes chosen in set menus are prepared the same way
+	err := d.db.Raw(`SELECT k.dish_id, MIN(k.name) AS name, SUM(k.quantity) AS quantity FROM (
+			SELECT ol.dish_id, ol.name, ol.quantity FROM order_lines ol
+				JOIN orders o ON o.id = ol.order_id
+				WHERE ol.set_menu_id = 0 AND date(o.delivery) = ? AND o.status <> 'cancelled' AND o.deleted_at IS NULL AND ol.deleted_at IS NULL
+			UNION ALL
+			SELECT c.dish_id, c.name, ol.quantity FROM order_line_choices c
+				JOIN order_lines ol ON ol.id = c.order_line_id
+				JOIN orders o ON o.id = ol.order_id
+				WHERE date(o.delivery) = ? AND o.status <> 'cancelled' AND o.deleted_at IS NULL AND ol.deleted_at IS NULL AND c.deleted_at IS NULL
+		) k GROUP BY k.dish_id ORDER BY name`, day, day).Scan(&dishes).Error
+	if err != nil {
+		log.Error().Err(err).Str("day", day).Msg("Failed to count kitchen dishes")
+		return dishes, err
+	}
+
+	// modifiers change how the dish is prepared - only single dishes have them
+	var modifiers []models.CountKitchenModifiers
+	err = d.db.Raw(`SELECT ol.dish_id, m.name, SUM(ol.quantity) AS quantity FROM order_line_modifiers m
+			JOIN order_lines ol ON ol.id = m.order_line_id
+			JOIN orders o ON o.id = ol.order_id
+			WHERE date(o.delivery) = ? AND o.status <> 'cancelled' AND o.deleted_at IS NULL AND ol.deleted_at IS NULL AND m.deleted_at IS NULL
+			GROUP BY ol.dish_id, m.name ORDER BY m.name`, day).Scan(&modifiers).Error
+	if err != nil {
+		log.Error().Err(err).Str("day", day).Msg("Failed to count kitchen modifiers")
+		return dishes, err
+	}
+
+	for i := range dishes {
+		for _, modifier := range modifiers {
+			if modifier.DishID == dishes[i].DishID {
+				dishes[i].Modifiers = append(dishes[i].Modifiers, modifier)
+			}
+		}
+	}
+
+	return dishes, nil
+}
+
 func (d *Database) OrderModifiable(orderId uint64, userId uint64) (bool, error) {
-	canProceed, deliveryTime, err := d.orderOwnedByUser(userId, orderId)
+	canProceed, owned, err := d.orderOwnedByUser(userId, orderId)
 	if err != nil {
 		log.Error().Err(err).Uint64("userId", userId).Uint64("orderId", orderId).Msg("Failed to check if order is owner by user")
 		return false, err
 	}
 	if !canProceed {
-		return false, errors.New("Order doesn't belong to this user")
+		return false, validationErrorf("Order doesn't belong to this user")
 	}
 
-	err = d.configChangesAllowed(deliveryTime)
+	err = d.orderChangesAllowed(owned.Status, owned.Delivery)
 	if err != nil {
 		return false, err
 	}
@@ -154,31 +197,117 @@ func (d *Database) OrderModifiable(orderId uint64, userId uint64) (bool, error)
 	return true, nil
 }
 
-func (d *Database) OrderSubvention(userId uint64) (float64, error) {
-	subvention, err := d.orderCalculateSubvention(userId)
-	return subvention, err
+// Prices a prospective order like OrderCreate, without saving anything.
+// Lines that cannot be ordered are left out and explained in the warnings.
+func (d *Database) OrderQuote(order models.Order) (models.OrderQuote, error) {
+	var err error
+	var quote models.OrderQuote
+
+	order.Delivery, err = d.configDelivery(order.Delivery)
+	if err != nil {
+		return quote, err
+	}
+	quote.Delivery = order.Delivery
+
+	err = d.configChangesAllowed(order.Delivery)
+	if err != nil {
+		quote.Warnings = append(quote.Warnings, err.Error())
+	}
+
+	var lines []models.OrderLine
+	for _, line := range order.OrderLines {
+		priced, err := d.orderLinePrice(d.db, line, order.Delivery)
+		if err != nil {
+			quote.Warnings = append(quote.Warnings, fmt.Sprintf("line %s cannot be ordered: %s", orderLineLabel(line), err.Error()))
+			continue
+		}
+
+		item := models.OrderQuoteLine{DishID: priced.DishID, SetMenuID: priced.SetMenuID, Name: priced.Name, Quantity: priced.Quantity, CostUnit: priced.CostUnit}
+		if priced.SetMenuID > 0 {
+			item.BasePrice = priced.CostUnit
+		} else {
+			var promotion *models.Promotion
+			item.BasePrice, _, promotion, err = d.dishCostBreakdown(d.db, priced.DishID, order.Delivery)
+			if err != nil {
+				return quote, err
+			}
+			if promotion != nil {
+				item.Promotion = promotion.Name
+				item.PromotionID = promotion.ID
+			}
+			for _, modifier := range priced.Modifiers {
+				item.ModifiersCost += modifier.CostDelta
+			}
+		}
+		item.Cost = item.CostUnit.Times(item.Quantity)
+		quote.Subtotal += item.Cost
+
+		quote.Lines = append(quote.Lines, item)
+		lines = append(lines, priced)
+	}
+	order.OrderLines = lines
+
+	order, err = d.orderCalculateCost(d.db, order)
+	if err != nil {
+		log.Error().Err(err).Interface("order", order).Msg("Failed to calculate cost quote")
+		return quote, err
+	}
+
+	quote.Discounts = order.Discounts
+	quote.Discount = order.Discount
+	quote.CostTotal = order.CostTotal
+	quote.Subvention = order.Subvention
+	quote.CostToPay = order.CostToPay
+
+	if len(order.SubventionRule) == 0 {
+		quote.Warnings = append(quote.Warnings, "no subvention - only the first order of each delivery day has it, when a subvention rule applies")
+	} else if order.Subvention == 0 && order.CostTotal > 0 {
+		quote.Warnings = append(quote.Warnings, fmt.Sprintf("monthly subvention cap of rule %q already used", order.SubventionRule))
+	}
+
+	return quote, nil
 }
 
-func (d *Database) orderCalculateCost(order models.Order) (models.Order, error) {
+func (d *Database) orderCalculateCost(tx *gorm.DB, order models.Order) (models.Order, error) {
 	var err error
 
-	order.Subvention, err = d.orderCalculateSubvention(order.UserID)
+	order.Discounts, err = d.orderDiscounts(tx, order.OrderLines, order.Delivery)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to calculate cost - calculate discounts")
+		return order, err
+	}
+
+	order.Discount = 0
+	for _, discount := range order.Discounts {
+		order.Discount += discount.Amount
+	}
+
+	// percentage subventions depend on the cost after discounts
+	costTotal, _ := d.orderCalculateCostNoDB(order.OrderLines, order.Discount, 0)
+	var rule models.SubventionRule
+	order.Subvention, rule, err = d.subventionCalculate(tx, order.UserID, order.ID, order.Delivery, costTotal)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to calculate cost - calculate subvention")
 		return order, err
 	}
+	order.SubventionRule, order.SubventionRuleID = rule.Name, rule.ID
 
-	order.CostTotal, order.CostToPay = d.orderCalculateCostNoDB(order.OrderLines, order.Subvention)
+	order.CostTotal, order.CostToPay = d.orderCalculateCostNoDB(order.OrderLines, order.Discount, order.Subvention)
 
 	return order, nil
 }
 
-func (d *Database) orderCalculateCostNoDB(lines []models.OrderLine, subvention float64) (float64, float64) {
-	var costTotal, costToPay float64 = 0, 0
+func (d *Database) orderCalculateCostNoDB(lines []models.OrderLine, discount models.Money, subvention models.Money) (models.Money, models.Money) {
+	var costTotal, costToPay models.Money = 0, 0
 
 	// Calculate total
 	for i := range lines {
-		costTotal += float64(lines[i].Quantity) * lines[i].CostUnit
+		costTotal += lines[i].CostUnit.Times(lines[i].Quantity)
+	}
+	// Apply order promotions
+	costTotal -= discount
+	if costTotal < 0 {
+		costTotal = 0
 	}
 	// Apply subvention
 	costToPay = costTotal - subvention
@@ -189,31 +318,145 @@ func (d *Database) orderCalculateCostNoDB(lines []models.OrderLine, subvention f
 	return costTotal, costToPay
 }
 
-func (d *Database) orderCalculateSubvention(userId uint64) (float64, error) {
+// Multi-buy promotions over all the dish lines of the order - in every group of Buy units, the cheapest ones are free
+func (d *Database) orderDiscounts(tx *gorm.DB, lines []models.OrderLine, delivery time.Time) ([]models.OrderDiscount, error) {
+	type unit struct {
+		dishId uint64
+		cost   models.Money
+		used   bool
+	}
+
+	var units []*unit
+	promotions := make(map[uint64]models.Promotion)
+	qualifies := make(map[uint64]map[uint64]bool) // promotion -> dishes
+	for _, line := range lines {
+		if line.DishID == 0 || line.SetMenuID > 0 {
+			continue
+		}
+		for i := uint(0); i < line.Quantity; i++ {
+			units = append(units, &unit{dishId: line.DishID, cost: line.CostUnit})
+		}
+
+		active, err := d.promotionsActive(tx, line.DishID, delivery, models.PromotionMultiBuy)
+		if err != nil {
+			return nil, err
+		}
+		for _, promotion := range active {
+			promotions[promotion.ID] = promotion
+			if qualifies[promotion.ID] == nil {
+				qualifies[promotion.ID] = make(map[uint64]bool)
+			}
+			qualifies[promotion.ID][line.DishID] = true
+		}
+	}
+
+	promotionIds := make([]uint64, 0, len(promotions))
+	for id := range promotions {
+		promotionIds = append(promotionIds, id)
+	}
+	sort.Slice(promotionIds, func(i, j int) bool { return promotionIds[i] < promotionIds[j] })
+
+	var discounts []models.OrderDiscount
+	for _, id := range promotionIds {
+		promotion := promotions[id]
+
+		var candidates []*unit
+		for _, u := range units {
+			if !u.used && qualifies[id][u.dishId] {
+				candidates = append(candidates, u)
+			}
+		}
+		sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].cost > candidates[j].cost })
+
+		var amount models.Money
+		buy := int(promotion.Buy)
+		for start := 0; start+buy <= len(candidates); start += buy {
+			group := candidates[start : start+buy]
+			for i, u := range group {
+				u.used = true
+				if i >= int(promotion.Pay) {
+					amount += u.cost
+				}
+			}
+		}
+
+		if amount > 0 {
+			discounts = append(discounts, models.OrderDiscount{PromotionID: promotion.ID, Name: promotionName(promotion), Amount: amount})
+		}
+	}
+
+	return discounts, nil
+}
+
+// Overwrites Name and CostUnit of a line with the catalog values (anti-tampering protection)
+func (d *Database) orderLinePrice(tx *gorm.DB, line models.OrderLine, delivery time.Time) (models.OrderLine, error) {
 	var err error
-	var subvention float64 = 0
 
-	// Allowed: multiple orders per day, but only first has subvention
-	err = d.db.Where("date(created_at) = date(?) AND user_id = ?", time.Now(), userId).First(&models.Order{}).Error
+	if line.Quantity < 1 {
+		return line, validationErrorf("quantity of %s must be at least 1", orderLineLabel(line))
+	}
+
+	line.Promotion, line.PromotionDiscount = "", 0
+	if line.SetMenuID > 0 {
+		return d.setMenuLine(tx, line)
+	}
+
+	var dish models.Dish
+	err = tx.Select("name", "archived").First(&dish, line.DishID).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
-		// Get subvention - this is the first order
-		subvention, err = d.configSubvention()
+		return line, validationErrorf("dish %d doesn't exist", line.DishID)
 	}
+	if err != nil {
+		log.Error().Err(err).Interface("line", line).Msg("Failed to read dish from order line")
+		return line, err
+	}
+	if dish.Archived {
+		return line, validationErrorf("dish %s cannot be ordered anymore", dish.Name)
+	}
+	line.Name = dish.Name
+	line.Choices = nil
 
-	return subvention, err
+	base, cost, promotion, err := d.dishCostBreakdown(tx, line.DishID, delivery)
+	if err != nil {
+		log.Error().Err(err).Uint64("dishId", line.DishID).Msg("Failed to read cost from dish")
+		return line, err
+	}
+	line.CostUnit = cost
+	if promotion != nil {
+		line.Promotion, line.PromotionDiscount = promotionName(*promotion), base-cost
+	}
+
+	var costDelta models.Money
+	line.Modifiers, costDelta, err = d.modifierLine(tx, line)
+	if err != nil {
+		return line, err
+	}
+	line.CostUnit += costDelta
+	if line.CostUnit < 0 {
+		line.CostUnit = 0
+	}
+
+	return line, nil
+}
+
+func orderLineLabel(line models.OrderLine) string {
+	if line.SetMenuID > 0 {
+		return fmt.Sprintf("set menu %d", line.SetMenuID)
+	}
+	return fmt.Sprintf("dish %d", line.DishID)
 }
 
-func (d *Database) orderOwnedByUser(userId uint64, orderId uint64) (bool, time.Time, error) {
+func (d *Database) orderOwnedByUser(userId uint64, orderId uint64) (bool, models.Order, error) {
 	var order models.Order
-	var err error = d.db.Select("delivery").Where("user_id = ? AND id = ?", userId, orderId).First(&order).Error
+	var err error = d.db.Select("delivery", "status").Where("user_id = ? AND id = ?", userId, orderId).First(&order).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return false, order.Delivery, errors.New("Order doesn't belong to this User")
+			return false, order, validationErrorf("Order doesn't belong to this User")
 		} else {
 			log.Error().Err(err).Uint64("userId", userId).Uint64("orderId", orderId).Msg("Failed to check if order belongs to user")
-			return false, order.Delivery, err
+			return false, order, err
 		}
 	}
 
-	return true, order.Delivery, nil
+	return true, order, nil
 }
@@ -0,0 +1,111 @@
+package orm
+
+import (
+	"testing"
+	"tfm_backend/models"
+)
+
+func TestOrderCalculateCost(t *testing.T) {
+	price := models.Promotion{Type: models.PromotionPrice, Cost: 500}
+	percentage := models.Promotion{Type: models.PromotionPercentage, Percentage: 20}
+	amount := models.Promotion{Type: models.PromotionAmount, Amount: 300}
+	fixed := models.SubventionRule{Type: models.SubventionFixed, Amount: 1000}
+	share := models.SubventionRule{Type: models.SubventionPercentage, Percentage: 50, Amount: 600}
+
+	tests := []struct {
+		name           string
+		lines          []models.OrderLine
+		discount       models.Money // multi-buy and coupon discounts of the order
+		rule           *models.SubventionRule
+		remaining      models.Money // left of the monthly cap
+		wantTotal      models.Money
+		wantToPay      models.Money
+		wantSubvention models.Money
+	}{
+		{
+			name:      "no promotions nor subvention",
+			lines:     []models.OrderLine{{CostUnit: 650, Quantity: 2}, {CostUnit: 199, Quantity: 1}},
+			wantTotal: 1499, wantToPay: 1499,
+		},
+		{
+			name:      "price promotion",
+			lines:     []models.OrderLine{{CostUnit: promotionUnitCost(price, 800), Quantity: 3}},
+			wantTotal: 1500, wantToPay: 1500,
+		},
+		{
+			name:      "percentage promotion rounded to the cent",
+			lines:     []models.OrderLine{{CostUnit: promotionUnitCost(percentage, 999), Quantity: 2}},
+			wantTotal: 1598, wantToPay: 1598,
+		},
+		{
+			name:      "amount promotion above the price is free",
+			lines:     []models.OrderLine{{CostUnit: promotionUnitCost(amount, 250), Quantity: 4}, {CostUnit: promotionUnitCost(amount, 1000), Quantity: 1}},
+			wantTotal: 700, wantToPay: 700,
+		},
+		{
+			name:      "multi-buy discount",
+			lines:     []models.OrderLine{{CostUnit: 400, Quantity: 3}},
+			discount:  400,
+			wantTotal: 800, wantToPay: 800,
+		},
+		{
+			name:      "discount above the total",
+			lines:     []models.OrderLine{{CostUnit: 300, Quantity: 1}},
+			discount:  500,
+			wantTotal: 0, wantToPay: 0,
+		},
+		{
+			name:  "fixed subvention",
+			lines: []models.OrderLine{{CostUnit: 1250, Quantity: 1}},
+			rule:  &fixed, remaining: subventionUnlimited,
+			wantTotal: 1250, wantToPay: 250, wantSubvention: 1000,
+		},
+		{
+			name:  "fixed subvention above the total",
+			lines: []models.OrderLine{{CostUnit: promotionUnitCost(price, 800), Quantity: 1}},
+			rule:  &fixed, remaining: subventionUnlimited,
+			wantTotal: 500, wantToPay: 0, wantSubvention: 500,
+		},
+		{
+			name:  "fixed subvention within the monthly cap",
+			lines: []models.OrderLine{{CostUnit: 1250, Quantity: 1}},
+			rule:  &fixed, remaining: 350,
+			wantTotal: 1250, wantToPay: 900, wantSubvention: 350,
+		},
+		{
+			name:  "percentage subvention after promotions",
+			lines: []models.OrderLine{{CostUnit: promotionUnitCost(percentage, 1000), Quantity: 1}},
+			rule:  &share, remaining: subventionUnlimited,
+			wantTotal: 800, wantToPay: 400, wantSubvention: 400,
+		},
+		{
+			name:     "percentage subvention after multi-buy discount",
+			lines:    []models.OrderLine{{CostUnit: 333, Quantity: 3}},
+			discount: 333,
+			rule:     &share, remaining: subventionUnlimited,
+			wantTotal: 666, wantToPay: 333, wantSubvention: 333,
+		},
+		{
+			name:  "percentage subvention up to its maximum",
+			lines: []models.OrderLine{{CostUnit: 2000, Quantity: 1}},
+			rule:  &share, remaining: subventionUnlimited,
+			wantTotal: 2000, wantToPay: 1400, wantSubvention: 600,
+		},
+	}
+
+	var d *Database
+	for _, test := range tests {
+		// percentage subventions depend on the cost after discounts, as in orderCalculateCost
+		costTotal, _ := d.orderCalculateCostNoDB(test.lines, test.discount, 0)
+		var subvention models.Money
+		if test.rule != nil {
+			subvention = subventionAmount(*test.rule, test.remaining, costTotal)
+		}
+		total, toPay := d.orderCalculateCostNoDB(test.lines, test.discount, subvention)
+
+		if subvention != test.wantSubvention || total != test.wantTotal || toPay != test.wantToPay {
+			t.Errorf("%s: subvention %s, total %s, to pay %s - want %s, %s, %s", test.name,
+				subvention, total, toPay, test.wantSubvention, test.wantTotal, test.wantToPay)
+		}
+	}
+}
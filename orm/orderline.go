@@ -5,68 +5,60 @@ import (
 
 	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 const errMsgTxCommit string = "Failed to commit changes to database for order conversion"
 
 func (d *Database) OrderLineCreate(userId uint64, orderId uint64, lineOrder models.OrderLine) (models.Order, error) {
 	// Only the owner of the order can add lines to it
-	canProceed, deliveryTime, err := d.orderOwnedByUser(userId, orderId)
+	canProceed, owned, err := d.orderOwnedByUser(userId, orderId)
 	if !canProceed {
 		return models.Order{}, err
 	}
 
 	// Changes must be done before kitchen starts preparing the food
-	err = d.configChangesAllowed(deliveryTime)
+	err = d.orderChangesAllowed(owned.Status, owned.Delivery)
 	if err != nil {
-		return d.OrderDetails(int64(userId), orderId)
+		return models.Order{}, err
 	}
 
+	// always a new line - ids of other lines are not taken from the client
+	lineOrder.ID = 0
 	lineOrder.OrderID = orderId
 
-	// Overwrite Name and CostUnit (anti-tampering protection)
-	var dish models.Dish
-	err = d.db.Select("name").First(&dish, lineOrder.DishID).Error
-	if err != nil {
-		log.Error().Err(err).Interface("line", lineOrder).Msg("Failed to read dish from cart line")
-		return d.OrderDetails(int64(userId), orderId)
-	}
-	lineOrder.Name = dish.Name
-
-	var costUnit float64
-	costUnit, err = d.dishCurrentCost(lineOrder.DishID)
-	if err != nil {
-		log.Error().Err(err).Uint64("dishId", lineOrder.DishID).Msg("Failed to read cost from dish")
-		return d.OrderDetails(int64(userId), orderId)
-	}
-	lineOrder.CostUnit = costUnit
-
 	// transaction block
 	{
 		tx := d.db.Begin()
 		defer tx.Rollback()
 
-		err = tx.Save(&lineOrder).Error
+		// Overwrite Name and CostUnit (anti-tampering protection) - missing modifiers, wrong set menu choices
+		// and archived dishes are rejected
+		lineOrder, err = d.orderLinePrice(tx, lineOrder, owned.Delivery)
+		if err != nil {
+			return models.Order{}, err
+		}
+
+		err = orderLineStockTake(tx, lineOrder, int(lineOrder.Quantity))
+		if err != nil {
+			return models.Order{}, err
+		}
+
+		err = tx.Create(&lineOrder).Error
 		if err != nil {
 			log.Error().Err(err).Interface("line", lineOrder).Msg("Failed to save line order")
-			// explicit rollback - we will call a non-tx function
-			tx.Rollback()
-			return d.OrderDetails(int64(userId), orderId)
+			return models.Order{}, err
 		}
 
 		err = d.orderUpdateCost(tx, orderId)
 		if err != nil {
-			// explicit rollback - we will call a non-tx function
-			tx.Rollback()
-			return d.OrderDetails(int64(userId), orderId)
+			return models.Order{}, err
 		}
 
 		err = tx.Commit().Error
 		if err != nil {
 			log.Error().Err(err).Uint64("orderId", orderId).Msg(errMsgTxCommit)
-			// explicit rollback - we will call a non-tx function
-			tx.Rollback()
-			return d.OrderDetails(int64(userId), orderId)
+			return models.Order{}, err
 		}
 	}
 
@@ -75,15 +67,15 @@ func (d *Database) OrderLineCreate(userId uint64, orderId uint64, lineOrder mode
 
 func (d *Database) OrderLineDelete(userId uint64, orderId uint64, lineId uint64) (models.Order, error) {
 	// Only the owner of the order can delete lines to it
-	canProceed, deliveryTime, err := d.orderOwnedByUser(userId, orderId)
+	canProceed, owned, err := d.orderOwnedByUser(userId, orderId)
 	if !canProceed {
 		return models.Order{}, err
 	}
 
 	// Changes must be done before kitchen starts preparing the food
-	err = d.configChangesAllowed(deliveryTime)
+	err = d.orderChangesAllowed(owned.Status, owned.Delivery)
 	if err != nil {
-		return d.OrderDetails(int64(userId), orderId)
+		return models.Order{}, err
 	}
 
 	// transaction block
@@ -91,27 +83,34 @@ func (d *Database) OrderLineDelete(userId uint64, orderId uint64, lineId uint64)
 		tx := d.db.Begin()
 		defer tx.Rollback()
 
-		err = tx.Delete(&models.OrderLine{}, lineId).Error
+		// lines of other orders are not found
+		var line models.OrderLine
+		err = tx.Preload("Choices").Where("order_id = ?", orderId).First(&line, lineId).Error
+		if err != nil {
+			log.Error().Err(err).Uint64("lineId", lineId).Msg("Failed to read line order")
+			return models.Order{}, err
+		}
+
+		err = tx.Delete(&line).Error
 		if err != nil {
 			log.Error().Err(err).Uint64("lineId", lineId).Msg("Failed to delete line order")
-			// explicit rollback - we will call a non-tx function
-			tx.Rollback()
-			return d.OrderDetails(int64(userId), orderId)
+			return models.Order{}, err
+		}
+
+		err = orderLineStockTake(tx, line, -int(line.Quantity))
+		if err != nil {
+			return models.Order{}, err
 		}
 
 		err = d.orderUpdateCost(tx, orderId)
 		if err != nil {
-			// explicit rollback - we will call a non-tx function
-			tx.Rollback()
-			return d.OrderDetails(int64(userId), orderId)
+			return models.Order{}, err
 		}
 
 		err = tx.Commit().Error
 		if err != nil {
 			log.Error().Err(err).Uint64("orderId", orderId).Msg(errMsgTxCommit)
-			// explicit rollback - we will call a non-tx function
-			tx.Rollback()
-			return d.OrderDetails(int64(userId), orderId)
+			return models.Order{}, err
 		}
 	}
 
@@ -119,16 +118,20 @@ func (d *Database) OrderLineDelete(userId uint64, orderId uint64, lineId uint64)
 }
 
 func (d *Database) OrderLineModify(userId uint64, line models.OrderLine) (models.Order, error) {
+	if line.Quantity < 1 {
+		return models.Order{}, validationErrorf("quantity must be at least 1, remove the line instead")
+	}
+
 	// Only the owner of the order can add lines to it
-	canProceed, deliveryTime, err := d.orderOwnedByUser(userId, line.OrderID)
+	canProceed, owned, err := d.orderOwnedByUser(userId, line.OrderID)
 	if !canProceed {
 		return models.Order{}, err
 	}
 
 	// Changes must be done before kitchen starts preparing the food
-	err = d.configChangesAllowed(deliveryTime)
+	err = d.orderChangesAllowed(owned.Status, owned.Delivery)
 	if err != nil {
-		return d.OrderDetails(int64(userId), line.OrderID)
+		return models.Order{}, err
 	}
 
 	// transaction block
@@ -136,29 +139,35 @@ func (d *Database) OrderLineModify(userId uint64, line models.OrderLine) (models
 		tx := d.db.Begin()
 		defer tx.Rollback()
 
-		// existing line - update quantity ONLY
-		err = tx.Model(&line).Update("quantity", line.Quantity).Error
+		// existing line of the order - update quantity ONLY
+		var current models.OrderLine
+		err = tx.Preload("Choices").Where("order_id = ?", line.OrderID).First(&current, line.ID).Error
+		if err != nil {
+			log.Error().Err(err).Interface("line", line).Msg("Failed to read order line")
+			return models.Order{}, err
+		}
+
+		err = orderLineStockTake(tx, current, int(line.Quantity)-int(current.Quantity))
+		if err != nil {
+			return models.Order{}, err
+		}
+
+		err = tx.Model(&current).Update("quantity", line.Quantity).Error
 		if err != nil {
 			log.Error().Err(err).Interface("line", line).Msg("Failed to save order line")
-			// explicit rollback - we will call a non-tx function
-			tx.Rollback()
-			return d.OrderDetails(int64(userId), line.OrderID)
+			return models.Order{}, err
 		}
 
 		// update costs in Order
 		err = d.orderUpdateCost(tx, line.OrderID)
 		if err != nil {
-			// explicit rollback - we will call a non-tx function
-			tx.Rollback()
-			return d.OrderDetails(int64(userId), line.OrderID)
+			return models.Order{}, err
 		}
 
 		err = tx.Commit().Error
 		if err != nil {
 			log.Error().Err(err).Uint64("orderId", line.OrderID).Msg(errMsgTxCommit)
-			// explicit rollback - we will call a non-tx function
-			tx.Rollback()
-			return d.OrderDetails(int64(userId), line.OrderID)
+			return models.Order{}, err
 		}
 	}
 
@@ -168,35 +177,123 @@ func (d *Database) OrderLineModify(userId uint64, line models.OrderLine) (models
 func (d *Database) orderUpdateCost(tx *gorm.DB, orderId uint64) error {
 	// Anything in this function needs to run using the transaction
 	var err error
-	var subvention float64 = 0
 
 	var aux models.Order
-	err = tx.Select("subvention").Where("id = ?", orderId).Find(&aux).Error
+	err = tx.Select("user_id", "delivery", "coupon_id").Where("id = ?", orderId).Find(&aux).Error
 	if err != nil {
 		log.Error().Err(err).Uint64("id", orderId).Msg("Failed to read order subvention")
 		return err
 	}
-	subvention = aux.Subvention
 
 	// Get lines
 	var lines []models.OrderLine
-	err = tx.Select("cost_unit", "quantity").Where("order_id = ?", orderId).Find(&lines).Error
+	err = tx.Select("dish_id", "set_menu_id", "cost_unit", "quantity").Where("order_id = ?", orderId).Find(&lines).Error
 	if err != nil {
 		log.Error().Err(err).Uint64("id", orderId).Msg("Failed to read order")
 		return err
 	}
 
+	// Order promotions depend on all the lines
+	discounts, err := d.orderDiscounts(tx, lines, aux.Delivery)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Unscoped().Where("order_id = ?", orderId).Delete(&models.OrderDiscount{}).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", orderId).Msg("Failed to delete order discounts")
+		return err
+	}
+
+	var discount models.Money
+	for i := range discounts {
+		discounts[i].OrderID = orderId
+		discount += discounts[i].Amount
+	}
+	if len(discounts) > 0 {
+		err = tx.Create(&discounts).Error
+		if err != nil {
+			log.Error().Err(err).Uint64("orderId", orderId).Msg("Failed to create order discounts")
+			return err
+		}
+	}
+
+	// Coupon discount depends on the lines and promotions - a coupon deleted later still applies
+	var couponDiscount models.Money
+	if aux.CouponID > 0 {
+		var coupon models.Coupon
+		err = tx.Unscoped().First(&coupon, aux.CouponID).Error
+		if err != nil {
+			log.Error().Err(err).Uint64("couponId", aux.CouponID).Msg("Failed to read order coupon")
+			return err
+		}
+
+		couponDiscount, err = d.couponDiscount(tx, coupon, lines, discount)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Subvention rules may depend on the cost after discounts
+	costTotal, _ := d.orderCalculateCostNoDB(lines, discount+couponDiscount, 0)
+	subvention, rule, err := d.subventionCalculate(tx, aux.UserID, orderId, aux.Delivery, costTotal)
+	if err != nil {
+		return err
+	}
+
 	// Calculate cost
-	var costTotal, costToPay float64 = d.orderCalculateCostNoDB(lines, subvention)
+	costTotal, costToPay := d.orderCalculateCostNoDB(lines, discount+couponDiscount, subvention)
 
-	// Update Order cost values
+	// Update Order cost values - map, so zero values are saved too
 	var curOrder models.Order
 	curOrder.ID = orderId
-	err = tx.Model(&curOrder).Updates(models.Order{CostTotal: costTotal, CostToPay: costToPay}).Error
+	err = tx.Model(&curOrder).Updates(map[string]interface{}{"cost_total": costTotal, "discount": discount, "coupon_discount": couponDiscount,
+		"subvention": subvention, "subvention_rule": rule.Name, "subvention_rule_id": rule.ID, "cost_to_pay": costToPay}).Error
 	if err != nil {
 		log.Error().Err(err).Uint64("orderId", orderId).Msg("Failed to update order")
 		return err
 	}
 
+	// removed lines are refunded
+	return d.orderPaymentAdjust(tx, orderId, costToPay)
+}
+
+// Takes the portions of the line from the stock of its dishes (the chosen ones for set menus) - negative quantities give them back.
+// Dishes without stock are unlimited.
+func orderLineStockTake(tx *gorm.DB, line models.OrderLine, quantity int) error {
+	if quantity == 0 {
+		return nil
+	}
+
+	dishIds := []uint64{line.DishID}
+	if line.SetMenuID > 0 {
+		dishIds = dishIds[:0]
+		for _, choice := range line.Choices {
+			dishIds = append(dishIds, choice.DishID)
+		}
+	}
+
+	for _, dishId := range dishIds {
+		// portions of deleted dishes are given back too
+		var dish models.Dish
+		err := tx.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "name", "stock").First(&dish, dishId).Error
+		if err != nil {
+			log.Error().Err(err).Uint64("dishId", dishId).Msg("Failed to read dish stock")
+			return err
+		}
+		if dish.Stock == nil {
+			continue
+		}
+		if quantity > int(*dish.Stock) {
+			return validationErrorf("dish %s is out of stock, %d portions left", dish.Name, *dish.Stock)
+		}
+
+		err = tx.Unscoped().Model(&dish).UpdateColumn("stock", gorm.Expr("stock - ?", quantity)).Error
+		if err != nil {
+			log.Error().Err(err).Uint64("dishId", dishId).Int("quantity", quantity).Msg("Failed to update dish stock")
+			return err
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,262 @@
+package orm
+
+import (
+	"fmt"
+	"tfm_backend/models"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Allowed changes of status - cancellations are done by OrderCancel
+var orderTransitions = map[string][]string{
+	models.OrderPlaced:      {models.OrderConfirmed},
+	models.OrderConfirmed:   {models.OrderPreparation},
+	models.OrderPreparation: {models.OrderReady},
+	models.OrderReady:       {models.OrderDelivering, models.OrderDelivered},
+	models.OrderDelivering:  {models.OrderDelivered},
+}
+
+// Statuses in which the customer can still change the lines of the order
+var orderEditableStatus = map[string]bool{
+	models.OrderPlaced:    true,
+	models.OrderConfirmed: true,
+}
+
+// Reasons administrators can give to cancel an order - customer when it is done on their behalf
+var orderCancelReasons = map[string]bool{
+	models.CancelCustomer:   true,
+	models.CancelOutOfStock: true,
+	models.CancelKitchen:    true,
+	models.CancelPayment:    true,
+	models.CancelOther:      true,
+}
+
+// Moves the order to a new status, recording who did it. Administrators can do any allowed transition,
+// customers can only cancel their own orders before the kitchen cutoff.
+func (d *Database) OrderStatusChange(userId uint64, isAdmin bool, orderId uint64, status string, comment string) (models.Order, error) {
+	var err error
+
+	if status == models.OrderCancelled {
+		return d.OrderCancel(userId, isAdmin, orderId, "", comment)
+	}
+
+	tx := d.db.Begin()
+	defer tx.Rollback()
+
+	var order models.Order
+	err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&order, orderId).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", orderId).Msg("Failed to read order for status change")
+		return models.Order{}, err
+	}
+
+	if !isAdmin {
+		if order.UserID != userId {
+			return models.Order{}, validationErrorf("Order doesn't belong to this User")
+		}
+		// customers cancel with OrderCancel, the rest of the statuses are changed by the kitchen
+		return models.Order{}, validationErrorf("only the kitchen can change the status of an order")
+	}
+
+	err = orderStatusTransition(order.Status, status)
+	if err != nil {
+		return models.Order{}, err
+	}
+
+	// the card authorization was released when the order cost more - the customer has to pay it again
+	if len(order.PaymentMethod) > 0 && order.PaymentStatus == models.PaymentUnpaid {
+		return models.Order{}, validationErrorf("order %d has to be paid again before the kitchen can go on with it", order.ID)
+	}
+
+	if status == models.OrderConfirmed {
+		// employees of a company pay with the meal wallet once the kitchen confirms the order
+		wallet, err := walletUser(tx, order.UserID)
+		if err != nil {
+			return models.Order{}, err
+		}
+		if wallet {
+			err = orderWalletCharge(tx, order)
+			if err != nil {
+				return models.Order{}, err
+			}
+		}
+	}
+
+	err = orderStatusSave(tx, order, status, userId, comment)
+	if err != nil {
+		return models.Order{}, err
+	}
+
+	err = tx.Commit().Error
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", orderId).Msg(errMsgTxCommit)
+		return models.Order{}, err
+	}
+
+	return d.OrderDetails(-1, orderId)
+}
+
+// Cancels the order, recording who did it and why - the order is kept in the history.
+// Administrators can cancel any order not delivered yet (the customer is notified), customers only their own before the kitchen cutoff.
+func (d *Database) OrderCancel(userId uint64, isAdmin bool, orderId uint64, reason string, comment string) (models.Order, error) {
+	var err error
+
+	tx := d.db.Begin()
+	defer tx.Rollback()
+
+	var order models.Order
+	err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&order, orderId).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", orderId).Msg("Failed to read order to cancel")
+		return models.Order{}, err
+	}
+
+	if isAdmin {
+		if len(reason) == 0 {
+			reason = models.CancelOther
+		}
+		if !orderCancelReasons[reason] {
+			return models.Order{}, validationErrorf("unknown cancellation reason %s", reason)
+		}
+		if order.Status == models.OrderDelivered || order.Status == models.OrderCancelled {
+			return models.Order{}, validationErrorf("order is %s, it cannot be cancelled", order.Status)
+		}
+	} else {
+		if order.UserID != userId {
+			return models.Order{}, validationErrorf("Order doesn't belong to this User")
+		}
+		reason = models.CancelCustomer
+		err = d.orderChangesAllowed(order.Status, order.Delivery)
+		if err != nil {
+			return models.Order{}, err
+		}
+	}
+
+	// the portions go back to the stock, the coupon can be used by another order,
+	// and the payment is given back - to the card or the meal wallet
+	var lines []models.OrderLine
+	err = tx.Preload("Choices").Where("order_id = ?", order.ID).Find(&lines).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", orderId).Msg("Failed to read order lines to cancel")
+		return models.Order{}, err
+	}
+	for _, line := range lines {
+		err = orderLineStockTake(tx, line, -int(line.Quantity))
+		if err != nil {
+			return models.Order{}, err
+		}
+	}
+
+	err = orderCouponRelease(tx, order)
+	if err != nil {
+		return models.Order{}, err
+	}
+	err = d.orderPaymentRefund(tx, order.ID)
+	if err != nil {
+		return models.Order{}, err
+	}
+
+	err = tx.Model(&order).Updates(map[string]interface{}{"cancelled_by": userId, "cancel_reason": reason}).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", orderId).Msg("Failed to save order cancellation")
+		return models.Order{}, err
+	}
+
+	err = orderStatusSave(tx, order, models.OrderCancelled, userId, comment)
+	if err != nil {
+		return models.Order{}, err
+	}
+
+	// the subvention of the day goes to the next order of the user, if it can still be changed
+	var next models.Order
+	err = tx.Select("id", "status", "delivery").Where("user_id = ? AND date(delivery) = date(?) AND status IN ?", order.UserID, order.Delivery,
+		[]string{models.OrderPlaced, models.OrderConfirmed}).Order("id").Limit(1).Find(&next).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", orderId).Msg("Failed to read next order of the day")
+		return models.Order{}, err
+	}
+	if next.ID > 0 && d.orderChangesAllowed(next.Status, next.Delivery) == nil {
+		err = d.orderUpdateCost(tx, next.ID)
+		if err != nil {
+			return models.Order{}, err
+		}
+	}
+
+	if order.UserID != userId {
+		message := fmt.Sprintf("Your order %d for %s was cancelled (%s)", order.ID, order.Delivery.Format("2006-01-02"), reason)
+		if len(comment) > 0 {
+			message += ": " + comment
+		}
+		err = d.notificationCreate(tx, order.UserID, message)
+		if err != nil {
+			return models.Order{}, err
+		}
+	}
+
+	err = tx.Commit().Error
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", orderId).Msg(errMsgTxCommit)
+		return models.Order{}, err
+	}
+
+	return d.OrderDetails(-1, orderId)
+}
+
+func (d *Database) OrderStatusHistory(userId int64, orderId uint64) ([]models.OrderStatusChange, error) {
+	var history []models.OrderStatusChange
+
+	// Only the owner of the order or an administrator can see it
+	if userId != -1 {
+		canProceed, _, err := d.orderOwnedByUser(uint64(userId), orderId)
+		if !canProceed {
+			return history, err
+		}
+	}
+
+	err := d.db.Preload("User", func(db *gorm.DB) *gorm.DB {
+		return db.Select("id", "name", "surname")
+	}).Where("order_id = ?", orderId).Order("created_at").Find(&history).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", orderId).Msg("Failed to read order status history")
+	}
+
+	return history, err
+}
+
+// Orders can be changed while the kitchen hasn't started them, before the cutoff of the delivery day
+func (d *Database) orderChangesAllowed(status string, delivery time.Time) error {
+	if !orderEditableStatus[status] {
+		return validationErrorf("order is %s, it cannot be changed anymore", status)
+	}
+
+	return d.configChangesAllowed(delivery)
+}
+
+func orderStatusTransition(from string, to string) error {
+	for _, status := range orderTransitions[from] {
+		if status == to {
+			return nil
+		}
+	}
+
+	return validationErrorf("order cannot change from %s to %s", from, to)
+}
+
+func orderStatusSave(tx *gorm.DB, order models.Order, status string, userId uint64, comment string) error {
+	err := tx.Model(&order).Update("status", status).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", order.ID).Str("status", status).Msg("Failed to change order status")
+		return err
+	}
+
+	change := models.OrderStatusChange{OrderID: order.ID, FromStatus: order.Status, Status: status, UserID: userId, Comment: comment}
+	err = tx.Omit("User").Create(&change).Error
+	if err != nil {
+		log.Error().Err(err).Interface("change", change).Msg("Failed to record order status change")
+	}
+
+	return err
+}
@@ -0,0 +1,278 @@
+package orm
+
+import (
+	"fmt"
+	"slices"
+	"tfm_backend/models"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Payment statuses a provider event can move an order to - late or repeated events never move it back
+var paymentTransitions = map[string][]string{
+	models.PaymentUnpaid:     {models.PaymentAuthorized, models.PaymentCaptured, models.PaymentFailed},
+	models.PaymentAuthorized: {models.PaymentCaptured, models.PaymentRefunded, models.PaymentFailed},
+	models.PaymentFailed:     {models.PaymentAuthorized, models.PaymentCaptured},
+	models.PaymentCaptured:   {models.PaymentRefunded},
+}
+
+// Order fields needed to refund or adjust its payment
+var orderPaymentFields = []string{"id", "user_id", "payment_method", "payment_id", "payment_status", "payment_amount", "payment_refunded"}
+
+// Authorizes the cost to pay of the order with the payment provider - it is captured at the kitchen cutoff
+func (d *Database) OrderCheckout(userId uint64, orderId uint64) (models.Order, error) {
+	var err error
+
+	tx := d.db.Begin()
+	defer tx.Rollback()
+
+	var order models.Order
+	err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&order, orderId).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", orderId).Msg("Failed to read order for checkout")
+		return models.Order{}, err
+	}
+	if order.UserID != userId {
+		return models.Order{}, validationErrorf("Order doesn't belong to this User")
+	}
+
+	err = d.orderChangesAllowed(order.Status, order.Delivery)
+	if err != nil {
+		return models.Order{}, err
+	}
+	if order.PaymentStatus == models.PaymentAuthorized || order.PaymentStatus == models.PaymentCaptured {
+		return models.Order{}, validationErrorf("order is already paid")
+	}
+	if order.CostToPay <= 0 {
+		return models.Order{}, validationErrorf("order has nothing to pay")
+	}
+	wallet, err := walletUser(tx, order.UserID)
+	if err != nil {
+		return models.Order{}, err
+	}
+	if wallet {
+		return models.Order{}, validationErrorf("orders of company employees are charged to their meal wallet when confirmed")
+	}
+
+	payment, err := d.payments.Authorize(order.CostToPay, fmt.Sprintf("order-%d", order.ID))
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", orderId).Msg("Failed to authorize payment")
+		return models.Order{}, err
+	}
+
+	err = tx.Model(&order).Updates(map[string]interface{}{
+		"payment_method":   d.payments.Name(),
+		"payment_id":       payment.ID,
+		"payment_secret":   payment.ClientSecret,
+		"payment_status":   payment.Status,
+		"payment_amount":   order.CostToPay,
+		"payment_refunded": models.Money(0),
+	}).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", orderId).Str("paymentId", payment.ID).Msg("Failed to save order payment")
+		return models.Order{}, err
+	}
+
+	err = tx.Commit().Error
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", orderId).Msg(errMsgTxCommit)
+		return models.Order{}, err
+	}
+
+	return d.OrderDetails(int64(userId), orderId)
+}
+
+// Updates the payment status of an order from a notification of the payment provider
+func (d *Database) PaymentWebhook(payload []byte, signature string) error {
+	event, err := d.payments.VerifyWebhook(payload, signature)
+	if err != nil {
+		return err
+	}
+
+	tx := d.db.Begin()
+	defer tx.Rollback()
+
+	var order models.Order
+	err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "payment_status").
+		Where("payment_method = ? AND payment_id = ?", d.payments.Name(), event.PaymentID).First(&order).Error
+	if err != nil {
+		log.Error().Err(err).Interface("event", event).Msg("Failed to read order of payment event")
+		return err
+	}
+
+	if !slices.Contains(paymentTransitions[order.PaymentStatus], event.Status) {
+		// repeated or late events are acknowledged, so the provider doesn't send them again
+		log.Warn().Interface("event", event).Uint64("orderId", order.ID).Str("paymentStatus", order.PaymentStatus).Msg("Payment event ignored")
+		return nil
+	}
+
+	err = tx.Model(&order).Update("payment_status", event.Status).Error
+	if err != nil {
+		log.Error().Err(err).Interface("event", event).Msg("Failed to update order payment status")
+		return err
+	}
+
+	err = tx.Commit().Error
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", order.ID).Msg(errMsgTxCommit)
+		return err
+	}
+
+	return nil
+}
+
+// Number of times the provider is asked for a refund before it is left to be given back by hand
+const paymentRefundAttempts = 5
+
+// Captures the authorized payments of the orders once their kitchen cutoff has passed
+func (d *Database) PaymentsCapture() error {
+	delivery, err := d.configTodayDelivery()
+	if err != nil {
+		return err
+	}
+
+	// orders of past days are captured too, in case the cutoff was missed
+	day := delivery
+	if d.configChangesAllowed(delivery) == nil {
+		day = delivery.AddDate(0, 0, -1)
+	}
+
+	// pending refunds release part of the authorization first, the rest is captured afterwards
+	var orders []models.Order
+	err = d.db.Select("id", "payment_id", "payment_amount", "payment_refunded").
+		Where("date(delivery) <= date(?) AND payment_status = ? AND status <> ?", day, models.PaymentAuthorized, models.OrderCancelled).
+		Where("NOT EXISTS (SELECT 1 FROM payment_refunds r WHERE r.order_id = orders.id AND r.status = ? AND r.deleted_at IS NULL)", models.RefundPending).
+		Find(&orders).Error
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to read payments to capture")
+		return err
+	}
+
+	for _, order := range orders {
+		status := models.PaymentCaptured
+		err = d.payments.Capture(order.PaymentID, order.PaymentAmount-order.PaymentRefunded)
+		if err != nil {
+			// the other payments are still captured
+			log.Error().Err(err).Uint64("orderId", order.ID).Str("paymentId", order.PaymentID).Msg("Failed to capture payment")
+			status = models.PaymentFailed
+		}
+
+		err = d.db.Model(&order).Update("payment_status", status).Error
+		if err != nil {
+			log.Error().Err(err).Uint64("orderId", order.ID).Str("status", status).Msg("Failed to save payment status")
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Asks the provider for the refunds recorded with the order changes. The idempotency key of each refund
+// keeps a retry from giving it back twice, when the provider did it but the status could not be saved.
+func (d *Database) PaymentRefundsProcess() error {
+	var refunds []models.PaymentRefund
+	err := d.db.Where("status = ?", models.RefundPending).Order("id").Find(&refunds).Error
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to read pending payment refunds")
+		return err
+	}
+
+	for _, refund := range refunds {
+		status := models.RefundDone
+		err = d.payments.Refund(refund.PaymentID, refund.Amount, fmt.Sprintf("refund-%d", refund.ID))
+		if err != nil {
+			log.Error().Err(err).Uint64("orderId", refund.OrderID).Str("paymentId", refund.PaymentID).Stringer("amount", refund.Amount).Msg("Failed to refund payment")
+			status = models.RefundPending
+			if refund.Attempts+1 >= paymentRefundAttempts {
+				status = models.RefundFailed
+			}
+		}
+
+		err = d.db.Model(&refund).Updates(map[string]interface{}{"status": status, "attempts": refund.Attempts + 1}).Error
+		if err != nil {
+			log.Error().Err(err).Uint64("refundId", refund.ID).Str("status", status).Msg("Failed to save payment refund status")
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Gives back everything paid for the order - cancelled orders
+func (d *Database) orderPaymentRefund(tx *gorm.DB, orderId uint64) error {
+	var order models.Order
+	err := tx.Select(orderPaymentFields).First(&order, orderId).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", orderId).Msg("Failed to read order payment")
+		return err
+	}
+	if order.PaymentStatus != models.PaymentAuthorized && order.PaymentStatus != models.PaymentCaptured {
+		return nil
+	}
+
+	return d.orderPaymentGiveBack(tx, order, order.PaymentAmount-order.PaymentRefunded, models.PaymentRefunded)
+}
+
+// Matches the payment to a new cost to pay of the order. The difference is given back when lines are removed.
+// When the order costs more, a confirmed order is charged again to the meal wallet, and card authorizations are released to pay again.
+func (d *Database) orderPaymentAdjust(tx *gorm.DB, orderId uint64, costToPay models.Money) error {
+	var order models.Order
+	err := tx.Select(orderPaymentFields).First(&order, orderId).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", orderId).Msg("Failed to read order payment")
+		return err
+	}
+
+	held := order.PaymentAmount - order.PaymentRefunded
+	if order.PaymentMethod == models.PaymentMethodWallet && order.PaymentStatus == models.PaymentCaptured {
+		if costToPay <= held {
+			return d.orderPaymentGiveBack(tx, order, held-costToPay, models.PaymentCaptured)
+		}
+
+		_, err = walletPost(tx, models.WalletTransaction{UserID: order.UserID, OrderID: order.ID, Type: models.WalletDebit,
+			Amount: held - costToPay, Description: fmt.Sprintf("order %d changed", order.ID)})
+		if err != nil {
+			return err
+		}
+		return tx.Model(&order).Update("payment_amount", order.PaymentAmount+costToPay-held).Error
+	}
+	if order.PaymentStatus != models.PaymentAuthorized {
+		return nil
+	}
+
+	switch {
+	case costToPay == held:
+		return nil
+	case costToPay == 0:
+		return d.orderPaymentGiveBack(tx, order, held, models.PaymentRefunded)
+	case costToPay < held:
+		return d.orderPaymentGiveBack(tx, order, held-costToPay, models.PaymentAuthorized)
+	default:
+		return d.orderPaymentGiveBack(tx, order, held, models.PaymentUnpaid)
+	}
+}
+
+func (d *Database) orderPaymentGiveBack(tx *gorm.DB, order models.Order, amount models.Money, status string) error {
+	if amount > 0 && order.PaymentMethod == models.PaymentMethodWallet {
+		_, err := walletPost(tx, models.WalletTransaction{UserID: order.UserID, OrderID: order.ID, Type: models.WalletReversal,
+			Amount: amount, Description: fmt.Sprintf("order %d reversed", order.ID)})
+		if err != nil {
+			return err
+		}
+	} else if amount > 0 {
+		// the provider is called by PaymentRefundsProcess once the changes are committed
+		err := tx.Create(&models.PaymentRefund{OrderID: order.ID, PaymentID: order.PaymentID, Amount: amount, Status: models.RefundPending}).Error
+		if err != nil {
+			log.Error().Err(err).Uint64("orderId", order.ID).Str("paymentId", order.PaymentID).Stringer("amount", amount).Msg("Failed to record payment refund")
+			return err
+		}
+	}
+
+	err := tx.Model(&order).Updates(map[string]interface{}{"payment_status": status, "payment_refunded": order.PaymentRefunded + amount}).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", order.ID).Msg("Failed to save payment refund")
+	}
+	return err
+}
@@ -2,9 +2,12 @@ package orm
 
 import (
 	"errors"
+	"fmt"
 	"tfm_backend/models"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
 )
 
@@ -19,20 +22,35 @@ func (d *Database) PromotionCount(activeOnly bool) (int64, error) {
 }
 
 func (d *Database) PromotionCreate(promotion models.Promotion) (models.Promotion, error) {
-	// don't allow 2 overlapping promotions for the same dish
-	err := d.db.Where("dish_id = ? and (? between start_time and end_time or ? between start_time and end_time)",
-		promotion.DishID, promotion.StartTime, promotion.EndTime).
-		First(&models.Promotion{}).Error
-	if errors.Is(err, gorm.ErrRecordNotFound) {
-		err := d.db.Create(&promotion).Error
+	err := promotionValidate(&promotion)
+	if err != nil {
+		return promotion, err
+	}
+
+	err = d.promotionCheckPrice(promotion)
+	if err != nil {
+		return promotion, err
+	}
+
+	tx := d.db.Begin()
+	defer tx.Rollback()
+
+	// overlapping promotions for the same dish or category are rejected by promotions_no_overlap
+	err = promotionSave(tx, promotion, func() error {
+		return tx.Omit("Dish", "Category").Create(&promotion).Error
+	})
+	if err != nil {
+		log.Error().Err(err).Interface("promotion", promotion).Msg("Failed to create Promotion")
 		return promotion, err
 	}
 
+	err = tx.Commit().Error
 	if err != nil {
+		log.Error().Err(err).Interface("promotion", promotion).Msg(errMsgTxCommit)
 		return promotion, err
 	}
 
-	return promotion, gorm.ErrDuplicatedKey
+	return promotion, nil
 }
 
 func (d *Database) PromotionDelete(promotionId uint64) error {
@@ -52,16 +70,320 @@ func (d *Database) PromotionList(activeOnly bool, limit uint64, offset uint64) (
 		scope = scope.Where("? BETWEEN start_time AND end_time", time.Now())
 	}
 	err := scope.Preload("Dish").Joins("LEFT JOIN dishes ON promotions.dish_id = dishes.id").
-		Preload("Dish.Allergens").
+		Preload("Dish.Allergens").Preload("Category").
 		Order("start_time DESC").Limit(int(limit)).Offset(int(offset)).Find(&promotions).Error
 	return promotions, err
 }
 
 func (d *Database) PromotionModify(promotion models.Promotion) (models.Promotion, error) {
-	err := d.db.Updates(&promotion).Error
+	var err error
+
+	tx := d.db.Begin()
+	defer tx.Rollback()
+
+	var current models.Promotion
+	err = tx.First(&current, promotion.ID).Error
+	if err != nil {
+		return promotion, err
+	}
+
+	err = promotionSave(tx, promotionMerge(current, promotion), func() error {
+		return tx.Omit("Dish", "Category").Updates(&promotion).Error
+	})
 	if err != nil {
+		log.Error().Err(err).Interface("promotion", promotion).Msg("Failed to modify Promotion")
+		return promotion, err
+	}
+
+	// Updates ignores empty values - validate the promotion as it has been saved
+	var saved models.Promotion
+	err = tx.First(&saved, promotion.ID).Error
+	if err != nil {
+		return promotion, err
+	}
+	err = promotionValidate(&saved)
+	if err != nil {
+		return promotion, err
+	}
+	err = d.promotionCheckPrice(saved)
+	if err != nil {
+		return promotion, err
+	}
+
+	err = tx.Commit().Error
+	if err != nil {
+		log.Error().Err(err).Interface("promotion", promotion).Msg("Failed to commit modify Promotion")
 		return promotion, err
 	}
 
 	return d.PromotionDetails(uint64(promotion.ID))
 }
+
+// The promotional price of a dish must be below its price when the promotion starts
+func (d *Database) promotionCheckPrice(promotion models.Promotion) error {
+	if promotion.Type != models.PromotionPrice || promotion.DishID == 0 {
+		return nil
+	}
+
+	cost, err := d.dishPriceAt(d.db, promotion.DishID, promotion.StartTime)
+	if err != nil {
+		log.Error().Err(err).Uint64("dishId", promotion.DishID).Msg("Failed to read dish price for promotion")
+		return err
+	}
+	if promotion.Cost >= cost {
+		return validationErrorf("promotion cost %s must be below the dish price %s", promotion.Cost, cost)
+	}
+
+	return nil
+}
+
+// Runs the save in a savepoint - when promotions_no_overlap rejects it, the transaction goes on
+// and the overlapping promotions are returned in a ConflictError
+func promotionSave(tx *gorm.DB, promotion models.Promotion, save func() error) error {
+	err := tx.SavePoint("promotion").Error
+	if err != nil {
+		return err
+	}
+
+	err = save()
+	var pgErr *pgconn.PgError
+	if err == nil || !errors.As(err, &pgErr) || pgErr.ConstraintName != "promotions_no_overlap" {
+		return err
+	}
+
+	err = tx.RollbackTo("promotion").Error
+	if err != nil {
+		return err
+	}
+
+	conflicts, err := promotionConflicts(tx, promotion)
+	if err != nil {
+		return err
+	}
+
+	log.Warn().Interface("promotion", promotion).Interface("conflicts", conflicts).Msg("Promotion overlaps other promotions")
+	return &ConflictError{Message: "Promotion overlaps other promotions of the same dish or category", Conflicts: conflicts}
+}
+
+// Promotions of the same dish or category running at the same time as the promotion, as promotions_no_overlap
+// finds them. Unit price promotions exclude each other, as multi-buy promotions do.
+func promotionConflicts(tx *gorm.DB, promotion models.Promotion) ([]models.Promotion, error) {
+	types := []string{models.PromotionPrice, models.PromotionPercentage, models.PromotionAmount}
+	if promotion.Type == models.PromotionMultiBuy {
+		types = []string{models.PromotionMultiBuy}
+	}
+
+	var promotions []models.Promotion
+	err := tx.Where("dish_id = ? AND category_id = ? AND id <> ? AND type IN ? AND start_time < ? AND end_time > ?",
+		promotion.DishID, promotion.CategoryID, promotion.ID, types, promotion.EndTime, promotion.StartTime).
+		Order("start_time").Find(&promotions).Error
+	if err != nil {
+		log.Error().Err(err).Interface("promotion", promotion).Msg("Failed to find overlapping Promotions")
+		return nil, err
+	}
+
+	var conflicts []models.Promotion
+	for _, other := range promotions {
+		if promotionWeekdaysOverlap(promotion.Weekdays, other.Weekdays) && promotionDailyOverlap(promotion, other) {
+			conflicts = append(conflicts, other)
+		}
+	}
+
+	return conflicts, nil
+}
+
+// The promotion as Updates saves it - empty values keep the current ones
+func promotionMerge(current models.Promotion, changes models.Promotion) models.Promotion {
+	if changes.DishID > 0 {
+		current.DishID = changes.DishID
+	}
+	if changes.CategoryID > 0 {
+		current.CategoryID = changes.CategoryID
+	}
+	if len(changes.Type) > 0 {
+		current.Type = changes.Type
+	}
+	if !changes.StartTime.IsZero() {
+		current.StartTime = changes.StartTime
+	}
+	if !changes.EndTime.IsZero() {
+		current.EndTime = changes.EndTime
+	}
+	if len(changes.DailyStart) > 0 {
+		current.DailyStart = changes.DailyStart
+	}
+	if len(changes.DailyEnd) > 0 {
+		current.DailyEnd = changes.DailyEnd
+	}
+	if len(changes.Weekdays) > 0 {
+		current.Weekdays = changes.Weekdays
+	}
+	return current
+}
+
+// Empty week days are every day
+func promotionWeekdaysOverlap(a string, b string) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return true
+	}
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if weekdaysContain(a, day) && weekdaysContain(b, day) {
+			return true
+		}
+	}
+	return false
+}
+
+// Happy hours overlap within the day - without them the promotion runs all day
+func promotionDailyOverlap(a models.Promotion, b models.Promotion) bool {
+	for _, x := range promotionDailyRanges(a) {
+		for _, y := range promotionDailyRanges(b) {
+			if x[0] < y[1] && y[0] < x[1] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Ranges [start, end) of the day in HH:MM - a happy hour crossing midnight is split in two
+func promotionDailyRanges(promotion models.Promotion) [][2]string {
+	if len(promotion.DailyStart) == 0 || len(promotion.DailyEnd) == 0 {
+		return [][2]string{{"00:00", "24:00"}}
+	}
+	if promotion.DailyStart <= promotion.DailyEnd {
+		return [][2]string{{promotion.DailyStart, promotion.DailyEnd}}
+	}
+	return [][2]string{{promotion.DailyStart, "24:00"}, {"00:00", promotion.DailyEnd}}
+}
+
+// Promotions of the dish (or of its categories) running at the time, of the given types
+func (d *Database) promotionsActive(tx *gorm.DB, dishId uint64, at time.Time, types ...string) ([]models.Promotion, error) {
+	categoryIds, err := d.categoryAncestors(tx, dishId)
+	if err != nil {
+		return nil, err
+	}
+
+	var promotions []models.Promotion
+	err = tx.Where("? BETWEEN start_time AND end_time AND type IN ?", at, types).
+		Where(tx.Where("dish_id = ?", dishId).Or("category_id IN ?", categoryIds)).
+		Order("id").Find(&promotions).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("dishId", dishId).Time("at", at).Msg("Failed to read active Promotions")
+		return nil, err
+	}
+
+	var active []models.Promotion
+	for _, promotion := range promotions {
+		if promotionRunsAt(promotion, at) {
+			active = append(active, promotion)
+		}
+	}
+
+	return active, nil
+}
+
+// Price of a unit after the promotion - multi-buy promotions are applied to the whole order
+func promotionUnitCost(promotion models.Promotion, cost models.Money) models.Money {
+	switch promotion.Type {
+	case models.PromotionPrice:
+		cost = promotion.Cost
+	case models.PromotionPercentage:
+		cost = cost.Percentage(100 - promotion.Percentage)
+	case models.PromotionAmount:
+		cost -= promotion.Amount
+	}
+
+	if cost < 0 {
+		cost = 0
+	}
+	return cost
+}
+
+// Name shown to the customer - promotions without one are described by what they give
+func promotionName(promotion models.Promotion) string {
+	if len(promotion.Name) > 0 {
+		return promotion.Name
+	}
+
+	switch promotion.Type {
+	case models.PromotionPercentage:
+		return fmt.Sprintf("%g%% off", promotion.Percentage)
+	case models.PromotionAmount:
+		return fmt.Sprintf("%s off", promotion.Amount)
+	case models.PromotionMultiBuy:
+		return fmt.Sprintf("%d for %d", promotion.Buy, promotion.Pay)
+	default:
+		return "Promotion"
+	}
+}
+
+// Checks the happy hour and week days of the promotion - the dates are checked by the query
+func promotionRunsAt(promotion models.Promotion, at time.Time) bool {
+	at = at.Local()
+
+	if !weekdaysContain(promotion.Weekdays, at.Weekday()) {
+		return false
+	}
+
+	if len(promotion.DailyStart) > 0 && len(promotion.DailyEnd) > 0 {
+		clock := at.Format("15:04")
+		if promotion.DailyStart <= promotion.DailyEnd {
+			return clock >= promotion.DailyStart && clock < promotion.DailyEnd
+		}
+		// happy hour crosses midnight
+		return clock >= promotion.DailyStart || clock < promotion.DailyEnd
+	}
+
+	return true
+}
+
+func promotionValidate(promotion *models.Promotion) error {
+	if len(promotion.Type) == 0 {
+		promotion.Type = models.PromotionPrice
+	}
+
+	if (promotion.DishID > 0) == (promotion.CategoryID > 0) {
+		return validationErrorf("a promotion applies either to a dish or to a category")
+	}
+	if !promotion.EndTime.After(promotion.StartTime) {
+		return validationErrorf("promotion must end after it starts")
+	}
+
+	switch promotion.Type {
+	case models.PromotionPrice:
+		if promotion.Cost < 0 {
+			return validationErrorf("promotion cost cannot be negative")
+		}
+	case models.PromotionPercentage:
+		if promotion.Percentage <= 0 || promotion.Percentage > 100 {
+			return validationErrorf("promotion percentage must be between 0 and 100")
+		}
+	case models.PromotionAmount:
+		if promotion.Amount <= 0 {
+			return validationErrorf("promotion amount must be positive")
+		}
+	case models.PromotionMultiBuy:
+		if promotion.Pay == 0 || promotion.Buy <= promotion.Pay {
+			return validationErrorf("multi-buy promotions need to buy more units than paid, and pay at least 1")
+		}
+	default:
+		return validationErrorf("unknown promotion type %s", promotion.Type)
+	}
+
+	if len(promotion.DailyStart) > 0 || len(promotion.DailyEnd) > 0 {
+		for _, clock := range []string{promotion.DailyStart, promotion.DailyEnd} {
+			_, err := time.Parse("15:04", clock)
+			if err != nil {
+				return validationErrorf("promotion daily times must be HH:MM: %s", clock)
+			}
+		}
+	}
+
+	err := weekdaysValidate(promotion.Weekdays)
+	if err != nil {
+		return validationErrorf("promotion %s", err)
+	}
+
+	return nil
+}
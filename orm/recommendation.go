@@ -0,0 +1,185 @@
+package orm
+
+import (
+	"math"
+	"sort"
+	"tfm_backend/models"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+const recommendationsPerUser = 20
+
+type recommendationSignal struct {
+	UserID uint64
+	DishID uint64
+	Weight float64
+}
+
+// Recommended dishes for the user, without disliked dishes or dishes with the user allergens
+func (d *Database) DishRecommendations(userId uint64, limit uint64, offset uint64) ([]models.Dish, error) {
+	var dishes []models.Dish
+
+	err := d.db.Preload("Allergens", func(db *gorm.DB) *gorm.DB {
+		return db.Order("allergens.name")
+	}).Preload("Categories", func(db *gorm.DB) *gorm.DB {
+		return db.Order("categories.position, categories.name")
+	}).Preload("Promotions").Scopes(dishesActive).
+		Joins("JOIN dish_recommendations ON dish_recommendations.dish_id = dishes.id AND dish_recommendations.deleted_at IS NULL").
+		Where("dish_recommendations.user_id = ?", userId).Scopes(dishesSuitable(userId)).
+		Order("dish_recommendations.score DESC").Limit(int(limit)).Offset(int(offset)).Find(&dishes).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", userId).Msg("Failed to list recommended dishes")
+		return dishes, err
+	}
+
+	if len(dishes) == 0 && offset == 0 {
+		// Nothing to recommend yet - show global favourites, with the same filters
+		err = d.db.Preload("Allergens", func(db *gorm.DB) *gorm.DB {
+			return db.Order("allergens.name")
+		}).Preload("Categories", func(db *gorm.DB) *gorm.DB {
+			return db.Order("categories.position, categories.name")
+		}).Preload("Promotions").Scopes(dishesActive, dishesSuitable(userId)).
+			Order("likes desc").Limit(int(limit)).Find(&dishes).Error
+		if err != nil {
+			log.Error().Err(err).Uint64("userId", userId).Msg("Failed to list favourite dishes for recommendations")
+			return dishes, err
+		}
+	}
+
+	return dishes, nil
+}
+
+// Dishes the user hasn't disliked and without the user allergens
+func dishesSuitable(userId uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("dishes.id NOT IN (SELECT dish_id FROM dish_dislikes WHERE user_id = ? AND deleted_at IS NULL)", userId).
+			Where(`dishes.id NOT IN (SELECT da.dish_id FROM dish_allergens da
+				JOIN user_allergens ua ON ua.allergen_id = da.allergen_id WHERE ua.user_id = ?)`, userId)
+	}
+}
+
+// Item-based collaborative filtering over likes, dislikes and ordered dishes.
+// Every user gets the dishes they haven't tried that are most similar to the ones they liked or ordered.
+func (d *Database) DishRecommendationsRefresh() error {
+	var signals []recommendationSignal
+	err := d.db.Raw(`SELECT user_id, dish_id, sum(weight) AS weight FROM (
+			SELECT user_id, dish_id, 2.0 AS weight FROM dish_likes WHERE deleted_at IS NULL
+			UNION ALL
+			SELECT user_id, dish_id, -2.0 AS weight FROM dish_dislikes WHERE deleted_at IS NULL
+			UNION ALL
+			SELECT o.user_id, ol.dish_id, 1.0 AS weight FROM order_lines ol JOIN orders o ON o.id = ol.order_id
+				WHERE ol.dish_id > 0 AND o.status <> 'cancelled' AND o.deleted_at IS NULL AND ol.deleted_at IS NULL
+			UNION ALL
+			SELECT o.user_id, c.dish_id, 1.0 AS weight FROM order_line_choices c
+				JOIN order_lines ol ON ol.id = c.order_line_id JOIN orders o ON o.id = ol.order_id
+				WHERE o.deleted_at IS NULL AND ol.deleted_at IS NULL AND c.deleted_at IS NULL
+		) s JOIN dishes ON dishes.id = s.dish_id AND dishes.deleted_at IS NULL
+		GROUP BY user_id, dish_id`).Scan(&signals).Error
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to read recommendation signals")
+		return err
+	}
+
+	// user -> dish -> weight, dish -> user -> weight
+	users := make(map[uint64]map[uint64]float64)
+	dishes := make(map[uint64]map[uint64]float64)
+	for _, signal := range signals {
+		// repeated orders count, but don't dominate likes
+		weight := math.Max(-2, math.Min(signal.Weight, 5))
+		if users[signal.UserID] == nil {
+			users[signal.UserID] = make(map[uint64]float64)
+		}
+		if dishes[signal.DishID] == nil {
+			dishes[signal.DishID] = make(map[uint64]float64)
+		}
+		users[signal.UserID][signal.DishID] = weight
+		dishes[signal.DishID][signal.UserID] = weight
+	}
+
+	similarity := recommendationSimilarity(dishes)
+
+	var recommendations []models.DishRecommendation
+	for userId, tried := range users {
+		scores := make(map[uint64]float64)
+		for dishId, weight := range tried {
+			for otherId, sim := range similarity[dishId] {
+				if _, ok := tried[otherId]; !ok {
+					scores[otherId] += sim * weight
+				}
+			}
+		}
+
+		var best []models.DishRecommendation
+		for dishId, score := range scores {
+			if score > 0 {
+				best = append(best, models.DishRecommendation{UserID: userId, DishID: dishId, Score: score})
+			}
+		}
+		sort.Slice(best, func(i, j int) bool {
+			return best[i].Score > best[j].Score
+		})
+		if len(best) > recommendationsPerUser {
+			best = best[:recommendationsPerUser]
+		}
+		recommendations = append(recommendations, best...)
+	}
+
+	tx := d.db.Begin()
+	defer tx.Rollback()
+
+	err = tx.Exec(`DELETE FROM dish_recommendations`).Error
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to clear recommendations")
+		return err
+	}
+
+	if len(recommendations) > 0 {
+		err = tx.CreateInBatches(&recommendations, 500).Error
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to save recommendations")
+			return err
+		}
+	}
+
+	err = tx.Commit().Error
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to commit recommendations")
+		return err
+	}
+
+	log.Info().Int("users", len(users)).Int("recommendations", len(recommendations)).Msg("Recommendations refreshed")
+	return nil
+}
+
+// Cosine similarity between every pair of dishes sharing at least one user
+func recommendationSimilarity(dishes map[uint64]map[uint64]float64) map[uint64]map[uint64]float64 {
+	norms := make(map[uint64]float64)
+	for dishId, users := range dishes {
+		for _, weight := range users {
+			norms[dishId] += weight * weight
+		}
+		norms[dishId] = math.Sqrt(norms[dishId])
+	}
+
+	similarity := make(map[uint64]map[uint64]float64)
+	for dishId, users := range dishes {
+		similarity[dishId] = make(map[uint64]float64)
+		for otherId, others := range dishes {
+			if otherId == dishId || norms[dishId] == 0 || norms[otherId] == 0 {
+				continue
+			}
+
+			var dot float64
+			for userId, weight := range users {
+				dot += weight * others[userId]
+			}
+			if dot != 0 {
+				similarity[dishId][otherId] = dot / (norms[dishId] * norms[otherId])
+			}
+		}
+	}
+
+	return similarity
+}
@@ -0,0 +1,147 @@
+package orm
+
+import (
+	"errors"
+	"tfm_backend/models"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (d *Database) ReviewDelete(userId uint64, dishId uint64) error {
+	tx := d.db.Begin()
+	defer tx.Rollback()
+
+	err := tx.Unscoped().Where("user_id = ? AND dish_id = ?", userId, dishId).Delete(&models.DishReview{}).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", userId).Uint64("dishId", dishId).Msg("Failed to delete review")
+		return err
+	}
+
+	err = reviewUpdateRatings(tx, dishId)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (d *Database) ReviewList(dishId uint64, limit uint64, offset uint64) ([]models.DishReview, error) {
+	var reviews []models.DishReview
+	err := d.db.Preload("User", func(db *gorm.DB) *gorm.DB {
+		return db.Select("id", "name", "surname")
+	}).Where("dish_id = ? AND hidden = false", dishId).
+		Order("updated_at DESC").Limit(int(limit)).Offset(int(offset)).Find(&reviews).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("dishId", dishId).Msg("Failed to list reviews")
+	}
+	return reviews, err
+}
+
+// Reviews with a comment not yet moderated
+func (d *Database) ReviewModerationList(limit uint64, offset uint64) ([]models.DishReview, error) {
+	var reviews []models.DishReview
+	err := d.db.Preload("User", func(db *gorm.DB) *gorm.DB {
+		return db.Select("id", "name", "surname", "email")
+	}).Where("moderated = false AND comment <> ''").
+		Order("updated_at").Limit(int(limit)).Offset(int(offset)).Find(&reviews).Error
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list reviews pending moderation")
+	}
+	return reviews, err
+}
+
+func (d *Database) ReviewModerate(reviewId uint64, hidden bool) (models.DishReview, error) {
+	var review models.DishReview
+
+	tx := d.db.Begin()
+	defer tx.Rollback()
+
+	err := tx.First(&review, reviewId).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("reviewId", reviewId).Msg("Failed to read review")
+		return review, err
+	}
+
+	err = tx.Model(&review).Updates(map[string]interface{}{"moderated": true, "hidden": hidden}).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("reviewId", reviewId).Msg("Failed to moderate review")
+		return review, err
+	}
+
+	err = reviewUpdateRatings(tx, review.DishID)
+	if err != nil {
+		return review, err
+	}
+
+	err = tx.Commit().Error
+	return review, err
+}
+
+// Creates or replaces the review of the user, only users who had the dish delivered can review it
+func (d *Database) ReviewSave(review models.DishReview) (models.DishReview, error) {
+	var err error
+
+	if review.Rating < 1 || review.Rating > 5 {
+		return review, errors.New("rating must be between 1 and 5")
+	}
+
+	var value uint64
+	res := d.db.Raw(`SELECT o.id FROM orders o JOIN order_lines ol ON ol.order_id = o.id
+			LEFT JOIN order_line_choices c ON c.order_line_id = ol.id AND c.deleted_at IS NULL
+		WHERE o.user_id = ? AND (ol.dish_id = ? OR c.dish_id = ?) AND o.status = ?
+			AND o.deleted_at IS NULL AND ol.deleted_at IS NULL LIMIT 1`,
+		review.UserID, review.DishID, review.DishID, models.OrderDelivered).Scan(&value)
+	if res.Error != nil {
+		log.Error().Err(res.Error).Interface("review", review).Msg("Failed to find orders with Dish")
+		return review, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return review, errors.New("only dishes you have ordered can be reviewed")
+	}
+
+	tx := d.db.Begin()
+	defer tx.Rollback()
+
+	// edited reviews go back to the moderation queue
+	review.Moderated = false
+	err = tx.Omit("User").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "dish_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"rating", "comment", "moderated", "updated_at"}),
+	}).Create(&review).Error
+	if err != nil {
+		log.Error().Err(err).Interface("review", review).Msg("Failed to save review")
+		return review, err
+	}
+
+	err = reviewUpdateRatings(tx, review.DishID)
+	if err != nil {
+		return review, err
+	}
+
+	err = tx.Commit().Error
+	if err != nil {
+		log.Error().Err(err).Interface("review", review).Msg("Failed to commit review")
+		return review, err
+	}
+
+	err = d.db.Where("user_id = ? AND dish_id = ?", review.UserID, review.DishID).First(&review).Error
+	return review, err
+}
+
+// Recalculates the rating counters of the dish from its visible reviews
+func reviewUpdateRatings(tx *gorm.DB, dishId uint64) error {
+	err := tx.Exec(`UPDATE dishes SET rating_count = r.total, rating_average = r.average,
+			ratings1 = r.r1, ratings2 = r.r2, ratings3 = r.r3, ratings4 = r.r4, ratings5 = r.r5
+		FROM (SELECT count(*) AS total, coalesce(avg(rating), 0) AS average,
+				count(*) FILTER (WHERE rating = 1) AS r1, count(*) FILTER (WHERE rating = 2) AS r2,
+				count(*) FILTER (WHERE rating = 3) AS r3, count(*) FILTER (WHERE rating = 4) AS r4,
+				count(*) FILTER (WHERE rating = 5) AS r5
+			FROM dish_reviews WHERE dish_id = ? AND hidden = false AND deleted_at IS NULL) r
+		WHERE dishes.id = ?`, dishId, dishId).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("dishId", dishId).Msg("Failed to update dish ratings")
+	}
+	return err
+}
@@ -0,0 +1,224 @@
+package orm
+
+import (
+	"errors"
+	"slices"
+	"tfm_backend/models"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+func (d *Database) SetMenuCreate(setMenu models.SetMenu) (models.SetMenu, error) {
+	err := d.db.Where("name = ?", setMenu.Name).First(&models.SetMenu{}).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = d.db.Create(&setMenu).Error
+		if err != nil {
+			log.Error().Err(err).Interface("setMenu", setMenu).Msg("Failed to create SetMenu")
+			return setMenu, err
+		}
+		return d.SetMenuDetails(setMenu.ID)
+	}
+
+	if err != nil {
+		return setMenu, err
+	}
+
+	// No error, we have found a matching set menu - return duplicated error
+	return setMenu, validationErrorf("set menu %s already exists", setMenu.Name)
+}
+
+func (d *Database) SetMenuDelete(setMenuId uint64) error {
+	return d.db.Delete(&models.SetMenu{}, setMenuId).Error
+}
+
+func (d *Database) SetMenuDetails(setMenuId uint64) (models.SetMenu, error) {
+	return d.setMenuRead(d.db, setMenuId)
+}
+
+func (d *Database) setMenuRead(tx *gorm.DB, setMenuId uint64) (models.SetMenu, error) {
+	var setMenu models.SetMenu
+	err := tx.Preload("Courses", func(db *gorm.DB) *gorm.DB {
+		return db.Order("set_menu_courses.position")
+	}).Preload("Courses.Dishes", func(db *gorm.DB) *gorm.DB {
+		return db.Order("dishes.name")
+	}).First(&setMenu, setMenuId).Error
+	return setMenu, err
+}
+
+func (d *Database) SetMenuList(limit uint64, offset uint64) ([]models.SetMenu, error) {
+	var setMenus []models.SetMenu
+	err := d.db.Preload("Courses", func(db *gorm.DB) *gorm.DB {
+		return db.Order("set_menu_courses.position")
+	}).Preload("Courses.Dishes", func(db *gorm.DB) *gorm.DB {
+		return db.Order("dishes.name")
+	}).Order("name").Limit(int(limit)).Offset(int(offset)).Find(&setMenus).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("limit", limit).Uint64("offset", offset).Msg("Failed to list SetMenus")
+	}
+	return setMenus, err
+}
+
+func (d *Database) SetMenuModify(setMenu models.SetMenu) (models.SetMenu, error) {
+	var err error
+
+	tx := d.db.Begin()
+	defer tx.Rollback()
+
+	err = tx.First(&models.SetMenu{}, setMenu.ID).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("setMenuId", setMenu.ID).Msg("Failed to read SetMenu")
+		return setMenu, err
+	}
+
+	err = tx.Omit("Courses").Updates(&setMenu).Error
+	if err != nil {
+		log.Error().Err(err).Interface("setMenu", setMenu).Msg("Failed to update SetMenu")
+		return setMenu, err
+	}
+
+	// replace courses - Update adds new records, but doesn't delete old ones.
+	// Only courses of this set menu keep their id, any other id is created as a new course
+	var existing []uint64
+	err = tx.Model(&models.SetMenuCourse{}).Where("set_menu_id = ?", setMenu.ID).Pluck("id", &existing).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("setMenuId", setMenu.ID).Msg("Failed to read SetMenu courses")
+		return setMenu, err
+	}
+
+	var keep []uint64
+	for i := range setMenu.Courses {
+		if !slices.Contains(existing, setMenu.Courses[i].ID) {
+			setMenu.Courses[i].ID = 0
+		}
+		setMenu.Courses[i].SetMenuID = setMenu.ID
+		err = tx.Omit("Dishes").Save(&setMenu.Courses[i]).Error
+		if err != nil {
+			log.Error().Err(err).Interface("course", setMenu.Courses[i]).Msg("Failed to save SetMenu course")
+			return setMenu, err
+		}
+
+		err = tx.Model(&setMenu.Courses[i]).Association("Dishes").Replace(setMenu.Courses[i].Dishes)
+		if err != nil {
+			log.Error().Err(err).Interface("course", setMenu.Courses[i]).Msg("Failed to replace SetMenu course dishes")
+			return setMenu, err
+		}
+		keep = append(keep, setMenu.Courses[i].ID)
+	}
+
+	scope := tx.Where("set_menu_id = ?", setMenu.ID)
+	if len(keep) > 0 {
+		scope = scope.Where("id NOT IN ?", keep)
+	}
+	err = scope.Delete(&models.SetMenuCourse{}).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("setMenuId", setMenu.ID).Msg("Failed to delete old SetMenu courses")
+		return setMenu, err
+	}
+
+	err = tx.Commit().Error
+	if err != nil {
+		log.Error().Err(err).Interface("setMenu", setMenu).Msg("Failed to commit modify SetMenu")
+		return setMenu, err
+	}
+
+	return d.SetMenuDetails(setMenu.ID)
+}
+
+// Validates the dish chosen for every course of the set menu, and fills the line snapshot (name, cost, course and dish names)
+func (d *Database) setMenuLine(tx *gorm.DB, line models.OrderLine) (models.OrderLine, error) {
+	if line.Quantity < 1 {
+		return line, validationErrorf("quantity of set menu %d must be at least 1", line.SetMenuID)
+	}
+
+	setMenu, err := d.setMenuRead(tx, line.SetMenuID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return line, validationErrorf("set menu %d doesn't exist", line.SetMenuID)
+	}
+	if err != nil {
+		log.Error().Err(err).Uint64("setMenuId", line.SetMenuID).Msg("Failed to read set menu from order line")
+		return line, err
+	}
+
+	if len(line.Choices) != len(setMenu.Courses) {
+		return line, validationErrorf("set menu %s requires one dish for each of its %d courses", setMenu.Name, len(setMenu.Courses))
+	}
+
+	choices := make([]models.OrderLineChoice, 0, len(setMenu.Courses))
+	for _, course := range setMenu.Courses {
+		var choice *models.OrderLineChoice
+		for i := range line.Choices {
+			if line.Choices[i].SetMenuCourseID == course.ID {
+				choice = &line.Choices[i]
+				break
+			}
+		}
+		if choice == nil {
+			return line, validationErrorf("no dish chosen for course %s of set menu %s", course.Name, setMenu.Name)
+		}
+
+		allowed, err := d.setMenuCourseAllows(tx, course, choice.DishID)
+		if err != nil {
+			return line, err
+		}
+		if !allowed {
+			return line, validationErrorf("dish %d cannot be chosen for course %s of set menu %s", choice.DishID, course.Name, setMenu.Name)
+		}
+
+		var dish models.Dish
+		err = tx.Select("name", "archived").First(&dish, choice.DishID).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return line, validationErrorf("dish %d doesn't exist", choice.DishID)
+		}
+		if err != nil {
+			log.Error().Err(err).Uint64("dishId", choice.DishID).Msg("Failed to read dish from set menu choice")
+			return line, err
+		}
+		if dish.Archived {
+			return line, validationErrorf("dish %s cannot be ordered anymore", dish.Name)
+		}
+
+		choices = append(choices, models.OrderLineChoice{
+			SetMenuCourseID: course.ID,
+			DishID:          choice.DishID,
+			CourseName:      course.Name,
+			Name:            dish.Name,
+		})
+	}
+
+	line.DishID = 0
+	line.Name = setMenu.Name
+	line.CostUnit = setMenu.Cost
+	line.Choices = choices
+
+	return line, nil
+}
+
+func (d *Database) setMenuCourseAllows(tx *gorm.DB, course models.SetMenuCourse, dishId uint64) (bool, error) {
+	if len(course.Dishes) > 0 {
+		for _, dish := range course.Dishes {
+			if dish.ID == dishId {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	// dishes of the category or any of its subcategories
+	if course.CategoryID > 0 {
+		categoryIds, err := d.categoryDescendants(tx, course.CategoryID)
+		if err != nil {
+			return false, err
+		}
+
+		var value uint64
+		res := tx.Raw(`SELECT dish_id FROM dish_categories WHERE category_id IN ? AND dish_id = ? LIMIT 1`, categoryIds, dishId).Scan(&value)
+		if res.Error != nil {
+			log.Error().Err(res.Error).Uint64("categoryId", course.CategoryID).Uint64("dishId", dishId).Msg("Failed to find Dish with Category")
+			return false, res.Error
+		}
+		return res.RowsAffected > 0, nil
+	}
+
+	return true, nil
+}
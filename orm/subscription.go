@@ -0,0 +1,295 @@
+package orm
+
+import (
+	"fmt"
+	"strings"
+	"tfm_backend/models"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+func (d *Database) SubscriptionCreate(subscription models.Subscription) (models.Subscription, error) {
+	err := subscriptionValidate(subscription)
+	if err != nil {
+		return subscription, err
+	}
+
+	subscription.Skips = nil
+	subscription.Materialized = time.Time{}
+	subscriptionLinesReset(subscription.Lines, 0)
+	err = d.db.Create(&subscription).Error
+	if err != nil {
+		log.Error().Err(err).Interface("subscription", subscription).Msg("Failed to create Subscription")
+		return subscription, err
+	}
+
+	return d.SubscriptionDetails(subscription.UserID, subscription.ID)
+}
+
+func (d *Database) SubscriptionDelete(userId uint64, subscriptionId uint64) error {
+	res := d.db.Where("user_id = ?", userId).Delete(&models.Subscription{}, subscriptionId)
+	if res.Error != nil {
+		log.Error().Err(res.Error).Uint64("subscriptionId", subscriptionId).Msg("Failed to delete Subscription")
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (d *Database) SubscriptionDetails(userId uint64, subscriptionId uint64) (models.Subscription, error) {
+	var subscription models.Subscription
+	err := d.db.Preload("Lines.Choices").Preload("Lines.Modifiers").Preload("Skips", func(db *gorm.DB) *gorm.DB {
+		return db.Where("day >= current_date").Order("day")
+	}).Where("user_id = ?", userId).First(&subscription, subscriptionId).Error
+	return subscription, err
+}
+
+func (d *Database) SubscriptionList(userId uint64, limit uint64, offset uint64) ([]models.Subscription, error) {
+	var subscriptions []models.Subscription
+	err := d.db.Preload("Lines.Choices").Preload("Lines.Modifiers").Preload("Skips", func(db *gorm.DB) *gorm.DB {
+		return db.Where("day >= current_date").Order("day")
+	}).Where("user_id = ?", userId).Order("name").Limit(int(limit)).Offset(int(offset)).Find(&subscriptions).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", userId).Msg("Failed to list Subscriptions")
+	}
+	return subscriptions, err
+}
+
+func (d *Database) SubscriptionModify(subscription models.Subscription) (models.Subscription, error) {
+	var err error
+
+	err = subscriptionValidate(subscription)
+	if err != nil {
+		return subscription, err
+	}
+
+	tx := d.db.Begin()
+	defer tx.Rollback()
+
+	// paused and skips have their own endpoints - empty weekdays orders every day
+	res := tx.Model(&models.Subscription{}).Where("id = ? AND user_id = ?", subscription.ID, subscription.UserID).
+		Updates(map[string]interface{}{"name": subscription.Name, "weekdays": subscription.Weekdays})
+	if res.Error != nil {
+		log.Error().Err(res.Error).Interface("subscription", subscription).Msg("Failed to update Subscription")
+		return subscription, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return subscription, gorm.ErrRecordNotFound
+	}
+
+	// replace lines - they are only read when the subscription is ordered, so new lines lose nothing
+	lines := "subscription_line_id IN (SELECT id FROM subscription_lines WHERE subscription_id = ? AND deleted_at IS NULL)"
+	err = tx.Where(lines, subscription.ID).Delete(&models.SubscriptionLineChoice{}).Error
+	if err == nil {
+		err = tx.Where(lines, subscription.ID).Delete(&models.SubscriptionLineModifier{}).Error
+	}
+	if err == nil {
+		err = tx.Where("subscription_id = ?", subscription.ID).Delete(&models.SubscriptionLine{}).Error
+	}
+	if err != nil {
+		log.Error().Err(err).Uint64("subscriptionId", subscription.ID).Msg("Failed to delete old Subscription lines")
+		return subscription, err
+	}
+
+	subscriptionLinesReset(subscription.Lines, subscription.ID)
+	err = tx.Create(&subscription.Lines).Error
+	if err != nil {
+		log.Error().Err(err).Interface("lines", subscription.Lines).Msg("Failed to create Subscription lines")
+		return subscription, err
+	}
+
+	err = tx.Commit().Error
+	if err != nil {
+		log.Error().Err(err).Interface("subscription", subscription).Msg("Failed to commit modify Subscription")
+		return subscription, err
+	}
+
+	return d.SubscriptionDetails(subscription.UserID, subscription.ID)
+}
+
+func (d *Database) SubscriptionPause(userId uint64, subscriptionId uint64, paused bool) (models.Subscription, error) {
+	res := d.db.Model(&models.Subscription{}).Where("id = ? AND user_id = ?", subscriptionId, userId).Update("paused", paused)
+	if res.Error != nil {
+		log.Error().Err(res.Error).Uint64("subscriptionId", subscriptionId).Bool("paused", paused).Msg("Failed to pause Subscription")
+		return models.Subscription{}, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return models.Subscription{}, gorm.ErrRecordNotFound
+	}
+
+	return d.SubscriptionDetails(userId, subscriptionId)
+}
+
+// Skips (or orders again) one day of the subscription
+func (d *Database) SubscriptionSkip(userId uint64, subscriptionId uint64, day time.Time, skip bool) (models.Subscription, error) {
+	var err error
+
+	_, err = d.SubscriptionDetails(userId, subscriptionId)
+	if err != nil {
+		return models.Subscription{}, err
+	}
+
+	if skip {
+		err = d.db.Where(models.SubscriptionSkip{SubscriptionID: subscriptionId, Day: day}).
+			FirstOrCreate(&models.SubscriptionSkip{SubscriptionID: subscriptionId, Day: day}).Error
+	} else {
+		err = d.db.Unscoped().Where("subscription_id = ? AND day = ?", subscriptionId, day).Delete(&models.SubscriptionSkip{}).Error
+	}
+	if err != nil {
+		log.Error().Err(err).Uint64("subscriptionId", subscriptionId).Time("day", day).Bool("skip", skip).Msg("Failed to skip Subscription day")
+		return models.Subscription{}, err
+	}
+
+	return d.SubscriptionDetails(userId, subscriptionId)
+}
+
+// Creates today's orders of the active subscriptions before the kitchen cutoff, with the current prices.
+// Lines that cannot be ordered are left out, and the user is notified.
+func (d *Database) SubscriptionsMaterialize() error {
+	delivery, err := d.configTodayDelivery()
+	if err != nil {
+		return err
+	}
+
+	// only business days before the cutoff
+	_, err = d.configDelivery(delivery)
+	if err != nil || d.configChangesAllowed(delivery) != nil {
+		return nil
+	}
+
+	var subscriptions []models.Subscription
+	// subscriptions are ordered once a day - a failure is notified, and not tried again
+	err = d.db.Preload("Lines.Choices").Preload("Lines.Modifiers").Where("paused = false AND (materialized IS NULL OR materialized < ?)", delivery.Format("2006-01-02")).
+		Where("NOT EXISTS (SELECT 1 FROM subscription_skips s WHERE s.subscription_id = subscriptions.id AND s.day = ? AND s.deleted_at IS NULL)", delivery.Format("2006-01-02")).
+		Where("NOT EXISTS (SELECT 1 FROM orders o WHERE o.subscription_id = subscriptions.id AND date(o.delivery) = date(?))", delivery).
+		Find(&subscriptions).Error
+	if err != nil {
+		log.Error().Err(err).Time("delivery", delivery).Msg("Failed to read Subscriptions to order")
+		return err
+	}
+
+	for _, subscription := range subscriptions {
+		if !weekdaysContain(subscription.Weekdays, delivery.Weekday()) {
+			continue
+		}
+
+		err = d.subscriptionOrder(subscription, delivery)
+		if err != nil {
+			// the other subscriptions are still ordered
+			log.Error().Err(err).Uint64("subscriptionId", subscription.ID).Msg("Failed to order Subscription")
+			continue
+		}
+
+		err = d.db.Model(&subscription).UpdateColumn("materialized", delivery.Format("2006-01-02")).Error
+		if err != nil {
+			log.Error().Err(err).Uint64("subscriptionId", subscription.ID).Msg("Failed to mark Subscription ordered")
+		}
+	}
+
+	return nil
+}
+
+func (d *Database) subscriptionOrder(subscription models.Subscription, delivery time.Time) error {
+	var user models.User
+	err := d.db.First(&user, subscription.UserID).Error
+	if err != nil {
+		return err
+	}
+
+	order := models.Order{
+		UserID:         user.ID,
+		SubscriptionID: subscription.ID,
+		Delivery:       delivery,
+		Address1:       user.Address1,
+		Address2:       user.Address2,
+		Address3:       user.Address3,
+		City:           user.City,
+		PostalCode:     user.PostalCode,
+		Phone:          user.Phone,
+	}
+
+	var unfulfilled []string
+	for _, line := range subscription.Lines {
+		orderLine := models.OrderLine{DishID: line.DishID, SetMenuID: line.SetMenuID, Quantity: line.Quantity}
+		for _, modifier := range line.Modifiers {
+			orderLine.Modifiers = append(orderLine.Modifiers, models.OrderLineModifier{ModifierID: modifier.ModifierID})
+		}
+		for _, choice := range line.Choices {
+			orderLine.Choices = append(orderLine.Choices, models.OrderLineChoice{SetMenuCourseID: choice.SetMenuCourseID, DishID: choice.DishID})
+		}
+
+		orderLine, err = d.orderLinePrice(d.db, orderLine, delivery)
+		if err != nil {
+			unfulfilled = append(unfulfilled, fmt.Sprintf("%s: %s", orderLineLabel(orderLine), err.Error()))
+			continue
+		}
+		order.OrderLines = append(order.OrderLines, orderLine)
+	}
+
+	if len(order.OrderLines) > 0 {
+		order, err = d.OrderCreate(order)
+		if err != nil {
+			unfulfilled = append(unfulfilled, err.Error())
+		}
+	}
+
+	if len(unfulfilled) > 0 {
+		message := fmt.Sprintf("Subscription %s for %s: ", subscription.Name, delivery.Format("2006-01-02"))
+		if order.ID == 0 {
+			message += "no order could be created. "
+		} else {
+			message += fmt.Sprintf("order %d created without some lines. ", order.ID)
+		}
+		message += strings.Join(unfulfilled, "; ")
+
+		err = d.notificationCreate(d.db, subscription.UserID, message)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func subscriptionValidate(subscription models.Subscription) error {
+	if len(subscription.Lines) == 0 {
+		return validationErrorf("subscriptions need at least one line")
+	}
+	for _, line := range subscription.Lines {
+		if (line.DishID == 0) == (line.SetMenuID == 0) || line.Quantity == 0 {
+			return validationErrorf("subscription lines need a dish or a set menu, and a quantity")
+		}
+		if line.SetMenuID > 0 && len(line.Modifiers) > 0 {
+			return validationErrorf("modifiers can only be chosen for dishes, not for set menu %d", line.SetMenuID)
+		}
+		if line.DishID > 0 && len(line.Choices) > 0 {
+			return validationErrorf("courses can only be chosen for set menus, not for dish %d", line.DishID)
+		}
+	}
+
+	err := weekdaysValidate(subscription.Weekdays)
+	if err != nil {
+		return validationErrorf("subscription %s", err)
+	}
+
+	return nil
+}
+
+// Lines are always created as new, with their choices and modifiers
+func subscriptionLinesReset(lines []models.SubscriptionLine, subscriptionId uint64) {
+	for i := range lines {
+		lines[i].ID = 0
+		lines[i].SubscriptionID = subscriptionId
+		for j := range lines[i].Choices {
+			lines[i].Choices[j].ID = 0
+		}
+		for j := range lines[i].Modifiers {
+			lines[i].Modifiers[j].ID = 0
+		}
+	}
+}
@@ -0,0 +1,241 @@
+package orm
+
+import (
+	"errors"
+	"math"
+	"tfm_backend/models"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+// Remaining monthly subvention of rules without cap
+const subventionUnlimited = models.Money(math.MaxInt64)
+
+func (d *Database) SubventionRuleCreate(rule models.SubventionRule) (models.SubventionRule, error) {
+	err := subventionRuleValidate(&rule)
+	if err != nil {
+		return rule, err
+	}
+
+	err = d.db.Create(&rule).Error
+	if err != nil {
+		log.Error().Err(err).Interface("rule", rule).Msg("Failed to create Subvention rule")
+		return models.SubventionRule{}, err
+	}
+
+	return rule, nil
+}
+
+func (d *Database) SubventionRuleDelete(ruleId uint64) error {
+	err := d.db.Delete(&models.SubventionRule{}, ruleId).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("ruleId", ruleId).Msg("Failed to delete Subvention rule")
+	}
+	return err
+}
+
+func (d *Database) SubventionRuleDetails(ruleId uint64) (models.SubventionRule, error) {
+	var rule models.SubventionRule
+	err := d.db.First(&rule, ruleId).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("ruleId", ruleId).Msg("Failed to detail Subvention rule")
+	}
+	return rule, err
+}
+
+func (d *Database) SubventionRuleList(limit uint64, offset uint64) ([]models.SubventionRule, error) {
+	var rules []models.SubventionRule
+	err := d.db.Order("priority, id").Limit(int(limit)).Offset(int(offset)).Find(&rules).Error
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list Subvention rules")
+	}
+	return rules, err
+}
+
+// Replaces the whole rule - zero values (no weekdays, no cap...) are meaningful
+func (d *Database) SubventionRuleModify(rule models.SubventionRule) (models.SubventionRule, error) {
+	err := subventionRuleValidate(&rule)
+	if err != nil {
+		return rule, err
+	}
+
+	_, err = d.SubventionRuleDetails(rule.ID)
+	if err != nil {
+		return rule, err
+	}
+
+	err = d.db.Omit("created_at").Save(&rule).Error
+	if err != nil {
+		log.Error().Err(err).Interface("rule", rule).Msg("Failed to update Subvention rule")
+		return models.SubventionRule{}, err
+	}
+
+	return d.SubventionRuleDetails(rule.ID)
+}
+
+// Subvention of a new order of the user for the delivery day, and the rule that gives it.
+// Percentage rules return their maximum, the amount depends on the order cost.
+func (d *Database) OrderSubvention(userId uint64, delivery time.Time) (models.Money, models.SubventionRule, error) {
+	rule, remaining, err := d.subventionRule(d.db, userId, 0, delivery)
+	if err != nil || rule == nil {
+		return 0, models.SubventionRule{}, err
+	}
+
+	amount := rule.Amount
+	if amount > remaining || (amount == 0 && rule.Type == models.SubventionPercentage && remaining < subventionUnlimited) {
+		amount = remaining
+	}
+	return amount, *rule, nil
+}
+
+// Subvention of an order with the cost after discounts, and the rule that gives it (empty = none).
+// orderId is 0 for new orders.
+func (d *Database) subventionCalculate(tx *gorm.DB, userId uint64, orderId uint64, delivery time.Time, costTotal models.Money) (models.Money, models.SubventionRule, error) {
+	rule, remaining, err := d.subventionRule(tx, userId, orderId, delivery)
+	if err != nil || rule == nil {
+		return 0, models.SubventionRule{}, err
+	}
+
+	return subventionAmount(*rule, remaining, costTotal), *rule, nil
+}
+
+// Subvention of the rule for the cost after discounts, within what is left of its monthly cap
+func subventionAmount(rule models.SubventionRule, remaining models.Money, costTotal models.Money) models.Money {
+	amount := rule.Amount
+	if rule.Type == models.SubventionPercentage {
+		amount = costTotal.Percentage(rule.Percentage)
+		if rule.Amount > 0 && amount > rule.Amount {
+			amount = rule.Amount
+		}
+	}
+	if amount > remaining {
+		amount = remaining
+	}
+	// what is not spent doesn't count for the monthly cap
+	if amount > costTotal {
+		amount = costTotal
+	}
+
+	return amount
+}
+
+// First rule that applies to the order (nil = no subvention), and how much is left of its monthly cap.
+// Only the first order of the user for each delivery day has subvention.
+func (d *Database) subventionRule(tx *gorm.DB, userId uint64, orderId uint64, delivery time.Time) (*models.SubventionRule, models.Money, error) {
+	var err error
+
+	var previous int64
+	scope := tx.Model(&models.Order{}).Where("date(delivery) = date(?) AND user_id = ? AND status <> ?", delivery, userId, models.OrderCancelled)
+	if orderId > 0 {
+		scope = scope.Where("id < ?", orderId)
+	}
+	err = scope.Count(&previous).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", userId).Msg("Failed to count orders of the day for subvention")
+		return nil, 0, err
+	}
+	if previous > 0 {
+		return nil, 0, nil
+	}
+
+	var rules []models.SubventionRule
+	err = tx.Where("disabled = false").Order("priority, id").Find(&rules).Error
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to read Subvention rules")
+		return nil, 0, err
+	}
+
+	// no rules - same subvention for everyone, every day
+	if len(rules) == 0 {
+		subvention, err := d.configSubvention()
+		if err != nil || subvention == 0 {
+			return nil, 0, err
+		}
+		return &models.SubventionRule{Name: "default", Type: models.SubventionFixed, Amount: subvention}, subventionUnlimited, nil
+	}
+
+	var user models.User
+	err = tx.Select("id", "company_id", "office_days").First(&user, userId).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", userId).Msg("Failed to read User for subvention")
+		return nil, 0, err
+	}
+
+	for i, rule := range rules {
+		if !weekdaysContain(rule.Weekdays, delivery.Weekday()) {
+			continue
+		}
+		if rule.CompanyID > 0 && rule.CompanyID != user.CompanyID {
+			continue
+		}
+		if rule.InOffice && !weekdaysContain(user.OfficeDays, delivery.Weekday()) {
+			continue
+		}
+
+		if rule.MonthlyCap == 0 {
+			return &rules[i], subventionUnlimited, nil
+		}
+
+		used, err := subventionUsedInMonth(tx, userId, orderId, delivery)
+		if err != nil {
+			return nil, 0, err
+		}
+		remaining := rule.MonthlyCap - used
+		if remaining < 0 {
+			remaining = 0
+		}
+		return &rules[i], remaining, nil
+	}
+
+	return nil, 0, nil
+}
+
+// Subvention of the other orders of the user delivered in the same month
+func subventionUsedInMonth(tx *gorm.DB, userId uint64, orderId uint64, delivery time.Time) (models.Money, error) {
+	delivery = delivery.In(time.Now().Location())
+	from := time.Date(delivery.Year(), delivery.Month(), 1, 0, 0, 0, 0, time.Now().Location())
+
+	var used models.Money
+	err := tx.Model(&models.Order{}).Select("COALESCE(SUM(subvention), 0)").
+		Where("user_id = ? AND id <> ? AND status <> ? AND delivery >= ? AND delivery < ?", userId, orderId, models.OrderCancelled, from, from.AddDate(0, 1, 0)).
+		Row().Scan(&used)
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", userId).Msg("Failed to read subvention used in the month")
+	}
+	return used, err
+}
+
+func subventionRuleValidate(rule *models.SubventionRule) error {
+	if len(rule.Type) == 0 {
+		rule.Type = models.SubventionFixed
+	}
+
+	switch rule.Type {
+	case models.SubventionFixed:
+		if rule.Amount <= 0 {
+			return errors.New("fixed subvention rules need a positive amount")
+		}
+	case models.SubventionPercentage:
+		if rule.Percentage <= 0 || rule.Percentage > 100 {
+			return errors.New("percentage subvention rules need a percentage between 0 and 100")
+		}
+		if rule.Amount < 0 {
+			return errors.New("subvention rule maximum cannot be negative")
+		}
+	default:
+		return errors.New("subvention rules can only be fixed or percentage")
+	}
+
+	if rule.MonthlyCap < 0 {
+		return errors.New("subvention rule monthly cap cannot be negative")
+	}
+
+	err := weekdaysValidate(rule.Weekdays)
+	if err != nil {
+		return validationErrorf("subvention rule %s", err)
+	}
+
+	return nil
+}
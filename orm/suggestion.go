@@ -0,0 +1,93 @@
+package orm
+
+import (
+	"tfm_backend/models"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+// Orders older than this are not used to mine association rules
+const suggestionHistoryDays = 180
+
+// Dishes frequently ordered together with the given ones, best rules first
+func (d *Database) DishSuggestions(dishIds []uint64, limit uint64) ([]models.Dish, error) {
+	var dishes []models.Dish
+	if len(dishIds) == 0 {
+		return dishes, nil
+	}
+
+	err := d.db.Preload("Allergens", func(db *gorm.DB) *gorm.DB {
+		return db.Order("allergens.name")
+	}).Preload("Categories", func(db *gorm.DB) *gorm.DB {
+		return db.Order("categories.position, categories.name")
+	}).Preload("Promotions").Scopes(dishesActive).
+		Joins(`JOIN (SELECT other_dish_id, max(confidence) AS confidence, max(lift) AS lift FROM dish_associations
+			WHERE dish_id IN ? AND other_dish_id NOT IN ? AND deleted_at IS NULL GROUP BY other_dish_id) s
+			ON s.other_dish_id = dishes.id`, dishIds, dishIds).
+		Order("s.confidence DESC, s.lift DESC").Limit(int(limit)).Find(&dishes).Error
+	if err != nil {
+		log.Error().Err(err).Interface("dishIds", dishIds).Msg("Failed to list suggested dishes")
+	}
+
+	return dishes, err
+}
+
+// Mines pairwise association rules (A -> B) from the dishes ordered together,
+// keeping the rules above the configured support and confidence
+func (d *Database) DishSuggestionsRefresh() error {
+	var config models.Configuration
+	err := d.db.Select("suggestion_min_support", "suggestion_min_confidence").First(&config).Error
+	if err != nil {
+		log.Error().Err(err).Msg(errMsgReadConfig)
+		return err
+	}
+
+	since := time.Now().AddDate(0, 0, -suggestionHistoryDays)
+
+	tx := d.db.Begin()
+	defer tx.Rollback()
+
+	err = tx.Exec(`DELETE FROM dish_associations`).Error
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to clear dish associations")
+		return err
+	}
+
+	result := tx.Exec(`WITH baskets AS (
+			SELECT DISTINCT ol.order_id, ol.dish_id FROM order_lines ol JOIN orders o ON o.id = ol.order_id
+				WHERE ol.dish_id > 0 AND o.delivery >= ? AND o.status <> 'cancelled' AND o.deleted_at IS NULL AND ol.deleted_at IS NULL
+			UNION
+			SELECT ol.order_id, c.dish_id FROM order_line_choices c
+				JOIN order_lines ol ON ol.id = c.order_line_id JOIN orders o ON o.id = ol.order_id
+				WHERE o.delivery >= ? AND o.status <> 'cancelled' AND o.deleted_at IS NULL AND ol.deleted_at IS NULL AND c.deleted_at IS NULL
+		), total AS (
+			SELECT count(DISTINCT order_id)::float AS orders FROM baskets
+		), single AS (
+			SELECT dish_id, count(*)::float AS orders FROM baskets GROUP BY dish_id
+		), pairs AS (
+			SELECT a.dish_id, b.dish_id AS other_dish_id, count(*)::float AS orders FROM baskets a
+				JOIN baskets b ON a.order_id = b.order_id AND a.dish_id <> b.dish_id
+			GROUP BY a.dish_id, b.dish_id
+		)
+		INSERT INTO dish_associations (dish_id, other_dish_id, support, confidence, lift, created_at, updated_at)
+		SELECT p.dish_id, p.other_dish_id, p.orders / t.orders, p.orders / a.orders, (p.orders / a.orders) / (b.orders / t.orders), ?, ?
+			FROM pairs p CROSS JOIN total t
+			JOIN single a ON a.dish_id = p.dish_id JOIN single b ON b.dish_id = p.other_dish_id
+		WHERE p.orders / t.orders >= ? AND p.orders / a.orders >= ?`,
+		since, since, time.Now(), time.Now(), config.SuggestionMinSupport, config.SuggestionMinConfidence)
+	if result.Error != nil {
+		log.Error().Err(result.Error).Msg("Failed to mine dish associations")
+		return result.Error
+	}
+
+	err = tx.Commit().Error
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to commit dish associations")
+		return err
+	}
+
+	log.Info().Int64("rules", result.RowsAffected).Msg("Dish associations refreshed")
+	return nil
+}
@@ -0,0 +1,188 @@
+package orm
+
+import (
+	"fmt"
+	"tfm_backend/models"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm/clause"
+)
+
+// Translatable fields per entity - the untranslated value is kept in the entity table, in the default language
+var translatableFields = map[string][]string{
+	"dishes":      {"name", "description"},
+	"categories":  {"name"},
+	"ingredients": {"name"},
+	"allergens":   {"name"},
+}
+
+func (d *Database) TranslationDelete(translationId uint64) error {
+	return d.db.Unscoped().Where("id = ?", translationId).Delete(&models.Translation{}).Error
+}
+
+func (d *Database) TranslationList(entity string, entityId uint64, language string) ([]models.Translation, error) {
+	var translations []models.Translation
+
+	scope := d.db
+	if len(entity) > 0 {
+		scope = scope.Where("entity = ?", entity)
+	}
+	if entityId > 0 {
+		scope = scope.Where("entity_id = ?", entityId)
+	}
+	if len(language) > 0 {
+		scope = scope.Where("language = ?", language)
+	}
+
+	err := scope.Order("entity, entity_id, field, language").Find(&translations).Error
+	if err != nil {
+		log.Error().Err(err).Str("entity", entity).Uint64("entityId", entityId).Msg("Failed to list Translations")
+	}
+	return translations, err
+}
+
+// Creates or replaces the translation of an entity field
+func (d *Database) TranslationSave(translation models.Translation) (models.Translation, error) {
+	fields, ok := translatableFields[translation.Entity]
+	if !ok {
+		return translation, validationErrorf("%s cannot be translated", translation.Entity)
+	}
+
+	valid := false
+	for _, field := range fields {
+		if field == translation.Field {
+			valid = true
+		}
+	}
+	if !valid {
+		return translation, validationErrorf("%s of %s cannot be translated", translation.Field, translation.Entity)
+	}
+
+	err := d.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "entity"}, {Name: "entity_id"}, {Name: "field"}, {Name: "language"}},
+		DoUpdates: clause.AssignmentColumns([]string{"value", "updated_at"}),
+	}).Create(&translation).Error
+	if err != nil {
+		log.Error().Err(err).Interface("translation", translation).Msg("Failed to save Translation")
+		return translation, err
+	}
+
+	var saved models.Translation
+	err = d.db.Where("entity = ? AND entity_id = ? AND field = ? AND language = ?",
+		translation.Entity, translation.EntityID, translation.Field, translation.Language).First(&saved).Error
+	return saved, err
+}
+
+func (d *Database) TranslateAllergens(language string, allergens []models.Allergen) []models.Allergen {
+	ids := make([]uint64, 0, len(allergens))
+	for _, allergen := range allergens {
+		ids = append(ids, allergen.ID)
+	}
+
+	values := d.translationValues(language, "allergens", ids)
+	for i := range allergens {
+		translationApply(values, "allergens", allergens[i].ID, "name", &allergens[i].Name)
+	}
+	return allergens
+}
+
+func (d *Database) TranslateCategories(language string, categories []models.Category) []models.Category {
+	ids := make([]uint64, 0, len(categories))
+	for _, category := range categories {
+		ids = append(ids, category.ID)
+	}
+
+	values := d.translationValues(language, "categories", ids)
+	for i := range categories {
+		translationApply(values, "categories", categories[i].ID, "name", &categories[i].Name)
+	}
+	return categories
+}
+
+// Replaces names and descriptions of the dishes, and their categories, ingredients and allergens,
+// with the requested language. Missing translations keep the default language value.
+func (d *Database) TranslateDishes(language string, dishes []models.Dish) []models.Dish {
+	var ids, categoryIds, ingredientIds, allergenIds []uint64
+	for i := range dishes {
+		ids = append(ids, dishes[i].ID)
+		for _, category := range dishes[i].Categories {
+			categoryIds = append(categoryIds, category.ID)
+		}
+		for _, ingredient := range dishes[i].Ingredients {
+			ingredientIds = append(ingredientIds, ingredient.ID)
+		}
+		for _, allergen := range dishes[i].Allergens {
+			allergenIds = append(allergenIds, allergen.ID)
+		}
+	}
+
+	values := d.translationValues(language, "dishes", ids)
+	categories := d.translationValues(language, "categories", categoryIds)
+	ingredients := d.translationValues(language, "ingredients", ingredientIds)
+	allergens := d.translationValues(language, "allergens", allergenIds)
+	for i := range dishes {
+		translationApply(values, "dishes", dishes[i].ID, "name", &dishes[i].Name)
+		translationApply(values, "dishes", dishes[i].ID, "description", &dishes[i].Description)
+		for j := range dishes[i].Categories {
+			translationApply(categories, "categories", dishes[i].Categories[j].ID, "name", &dishes[i].Categories[j].Name)
+		}
+		for j := range dishes[i].Ingredients {
+			translationApply(ingredients, "ingredients", dishes[i].Ingredients[j].ID, "name", &dishes[i].Ingredients[j].Name)
+		}
+		for j := range dishes[i].Allergens {
+			translationApply(allergens, "allergens", dishes[i].Allergens[j].ID, "name", &dishes[i].Allergens[j].Name)
+		}
+	}
+	return dishes
+}
+
+func (d *Database) TranslateIngredients(language string, ingredients []models.Ingredient) []models.Ingredient {
+	ids := make([]uint64, 0, len(ingredients))
+	for _, ingredient := range ingredients {
+		ids = append(ids, ingredient.ID)
+	}
+
+	values := d.translationValues(language, "ingredients", ids)
+	for i := range ingredients {
+		translationApply(values, "ingredients", ingredients[i].ID, "name", &ingredients[i].Name)
+	}
+	return ingredients
+}
+
+// Search filter matching the default value of the column or its translation to the language
+func translationSearch(entity string, field string, language string, searchTerm string) (string, []interface{}) {
+	filter := fmt.Sprintf(`%%%s%%`, searchTerm)
+	query := fmt.Sprintf(`%s ILIKE ? OR id IN (SELECT entity_id FROM translations WHERE entity = ? AND field = ? AND language = ? AND value ILIKE ? AND deleted_at IS NULL)`, field)
+	return query, []interface{}{filter, entity, field, language, filter}
+}
+
+func translationApply(values map[string]string, entity string, entityId uint64, field string, target *string) {
+	if value, ok := values[translationKey(entity, entityId, field)]; ok && len(value) > 0 {
+		*target = value
+	}
+}
+
+func translationKey(entity string, entityId uint64, field string) string {
+	return fmt.Sprintf(`%s:%d:%s`, entity, entityId, field)
+}
+
+func (d *Database) translationValues(language string, entity string, ids []uint64) map[string]string {
+	values := make(map[string]string)
+	if len(ids) == 0 || len(language) == 0 {
+		return values
+	}
+
+	var translations []models.Translation
+	err := d.db.Select("entity_id", "field", "value").
+		Where("entity = ? AND language = ? AND entity_id IN ?", entity, language, ids).Find(&translations).Error
+	if err != nil {
+		// we fallback to the default language
+		log.Error().Err(err).Str("entity", entity).Str("language", language).Msg("Failed to read Translations")
+		return values
+	}
+
+	for _, translation := range translations {
+		values[translationKey(entity, translation.EntityID, translation.Field)] = translation.Value
+	}
+	return values
+}
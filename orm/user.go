@@ -39,7 +39,9 @@ func (d *Database) UserDelete(userId uint64) error {
 
 func (d *Database) UserDetails(userId uint64) (models.User, error) {
 	var user models.User
-	err := d.db.First(&user, userId).Error
+	err := d.db.Preload("Allergens", func(db *gorm.DB) *gorm.DB {
+		return db.Order("allergens.name")
+	}).First(&user, userId).Error
 	// Don't return the password hash
 	user.Password = ""
 	return user, err
@@ -59,13 +61,26 @@ func (d *Database) UserList(searchTerm string, limit uint64, offset uint64) ([]m
 }
 
 func (d *Database) UserModify(user models.User) (models.User, error) {
-	err := d.db.Updates(&user).Error
+	err := weekdaysValidate(user.OfficeDays)
+	if err != nil {
+		return user, validationErrorf("office %s", err)
+	}
+
+	err = d.db.Omit("Allergens").Updates(&user).Error
 	// Don't return the password hash
 	user.Password = ""
 	if err != nil {
 		return user, err
 	}
 
+	if user.Allergens != nil {
+		// replace allergens - Update adds new records, but doesn't delete old ones
+		err = d.db.Model(&user).Association("Allergens").Replace(user.Allergens)
+		if err != nil {
+			return user, err
+		}
+	}
+
 	if !user.IsAdmin {
 		// Update admin flag - gorm will not update false
 		err = d.db.Model(&user).Updates(map[string]interface{}{"is_admin": false}).Error
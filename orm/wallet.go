@@ -0,0 +1,304 @@
+package orm
+
+import (
+	"errors"
+	"fmt"
+	"tfm_backend/models"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (d *Database) WalletDetails(userId uint64) (models.Wallet, error) {
+	// users without movements have an empty wallet
+	wallet := models.Wallet{UserID: userId}
+	err := d.db.Where("user_id = ?", userId).Find(&wallet).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", userId).Msg("Failed to read Wallet")
+	}
+	return wallet, err
+}
+
+// Credit limit of the wallet - nil removes the limit, 0 allows no credit
+func (d *Database) WalletLimit(userId uint64, creditLimit *models.Money) (models.Wallet, error) {
+	if creditLimit != nil && *creditLimit < 0 {
+		return models.Wallet{}, errors.New("credit limit cannot be negative")
+	}
+
+	tx := d.db.Begin()
+	defer tx.Rollback()
+
+	wallet, err := walletLock(tx, userId)
+	if err != nil {
+		return wallet, err
+	}
+
+	// map, so a zero or null limit is saved too
+	err = tx.Model(&wallet).Updates(map[string]interface{}{"credit_limit": creditLimit}).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", userId).Msg("Failed to update Wallet credit limit")
+		return wallet, err
+	}
+
+	err = tx.Commit().Error
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", userId).Msg(errMsgTxCommit)
+		return wallet, err
+	}
+
+	return d.WalletDetails(userId)
+}
+
+// Credits and adjustments made by an administrator - debits come from the orders
+func (d *Database) WalletTransactionCreate(adminId uint64, userId uint64, request models.WalletTransactionRequest) (models.WalletTransaction, error) {
+	switch request.Type {
+	case models.WalletCredit:
+		if request.Amount <= 0 {
+			return models.WalletTransaction{}, errors.New("credits need a positive amount")
+		}
+	case models.WalletAdjustment:
+		if request.Amount == 0 {
+			return models.WalletTransaction{}, errors.New("adjustments need an amount")
+		}
+	default:
+		return models.WalletTransaction{}, fmt.Errorf("wallet transactions can only be %s or %s", models.WalletCredit, models.WalletAdjustment)
+	}
+
+	tx := d.db.Begin()
+	defer tx.Rollback()
+
+	transaction, err := walletPost(tx, models.WalletTransaction{UserID: userId, Type: request.Type, Amount: request.Amount, Description: request.Description, CreatedBy: adminId})
+	if err != nil {
+		return transaction, err
+	}
+
+	err = tx.Commit().Error
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", userId).Msg(errMsgTxCommit)
+	}
+	return transaction, err
+}
+
+func (d *Database) WalletTransactionList(userId uint64, limit uint64, offset uint64) ([]models.WalletTransaction, error) {
+	var transactions []models.WalletTransaction
+	err := d.db.Where("user_id = ?", userId).Order("created_at DESC, id DESC").Limit(int(limit)).Offset(int(offset)).Find(&transactions).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", userId).Msg("Failed to list Wallet transactions")
+	}
+	return transactions, err
+}
+
+// Wallet movements of the employee in the month, with the amount to deduct from payroll
+func (d *Database) WalletStatement(userId uint64, month time.Time) (models.WalletStatement, error) {
+	var user models.User
+	err := d.db.Select("id", "email", "name", "surname").First(&user, userId).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", userId).Msg("Failed to read User for Wallet statement")
+		return models.WalletStatement{}, err
+	}
+
+	return d.walletStatement(user, month, true)
+}
+
+// Posts the payroll deduction of every employee of the company for the month, once it has ended.
+// Employees already settled for the month are left as they are.
+func (d *Database) CompanySettle(adminId uint64, companyId uint64, month time.Time) (models.CompanyStatement, error) {
+	from := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.Now().Location())
+	if time.Now().Before(from.AddDate(0, 1, 0)) {
+		return models.CompanyStatement{}, validationErrorf("payroll is settled once the month has ended")
+	}
+
+	statement, err := d.CompanyStatement(companyId, from)
+	if err != nil {
+		return statement, err
+	}
+
+	tx := d.db.Begin()
+	defer tx.Rollback()
+
+	description := "payroll " + statement.Month
+	for _, employee := range statement.Employees {
+		if employee.Payroll <= 0 {
+			continue
+		}
+
+		// settled once - the wallet is locked before looking for the settlement
+		_, err = walletLock(tx, employee.UserID)
+		if err != nil {
+			return statement, err
+		}
+		var settled int64
+		err = tx.Model(&models.WalletTransaction{}).Where("user_id = ? AND type = ? AND description = ?", employee.UserID, models.WalletPayroll, description).
+			Count(&settled).Error
+		if err != nil {
+			log.Error().Err(err).Uint64("userId", employee.UserID).Msg("Failed to read Wallet payroll settlement")
+			return statement, err
+		}
+		if settled > 0 {
+			continue
+		}
+
+		_, err = walletPost(tx, models.WalletTransaction{UserID: employee.UserID, Type: models.WalletPayroll, Amount: employee.Payroll,
+			Description: description, CreatedBy: adminId})
+		if err != nil {
+			return statement, err
+		}
+	}
+
+	err = tx.Commit().Error
+	if err != nil {
+		log.Error().Err(err).Uint64("companyId", companyId).Msg(errMsgTxCommit)
+		return statement, err
+	}
+
+	return statement, nil
+}
+
+// Statements of all the employees of the company in the month, for payroll
+func (d *Database) CompanyStatement(companyId uint64, month time.Time) (models.CompanyStatement, error) {
+	company, err := d.CompanyDetails(companyId)
+	if err != nil {
+		return models.CompanyStatement{}, err
+	}
+
+	statement := models.CompanyStatement{CompanyID: company.ID, Name: company.Name, Month: month.Format("2006-01"), Employees: []models.WalletStatement{}}
+
+	var employees []models.User
+	err = d.db.Select("id", "email", "name", "surname").Where("company_id = ?", companyId).Order("surname, name").Find(&employees).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("companyId", companyId).Msg("Failed to read Company employees")
+		return statement, err
+	}
+
+	for _, employee := range employees {
+		item, err := d.walletStatement(employee, month, false)
+		if err != nil {
+			return statement, err
+		}
+		statement.Payroll += item.Payroll
+		statement.Employees = append(statement.Employees, item)
+	}
+
+	return statement, nil
+}
+
+func (d *Database) walletStatement(user models.User, month time.Time, details bool) (models.WalletStatement, error) {
+	from := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.Now().Location())
+	to := from.AddDate(0, 1, 0)
+	statement := models.WalletStatement{UserID: user.ID, Email: user.Email, Name: user.Name, Surname: user.Surname, Month: from.Format("2006-01")}
+
+	// balance after the last transaction of the previous months
+	var last models.WalletTransaction
+	err := d.db.Select("balance").Where("user_id = ? AND created_at < ?", user.ID, from).Order("created_at DESC, id DESC").Limit(1).Find(&last).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", user.ID).Msg("Failed to read Wallet opening balance")
+		return statement, err
+	}
+	statement.Opening = last.Balance
+
+	var transactions []models.WalletTransaction
+	err = d.db.Where("user_id = ? AND created_at >= ? AND created_at < ?", user.ID, from, to).Order("created_at, id").Find(&transactions).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", user.ID).Msg("Failed to read Wallet transactions of the month")
+		return statement, err
+	}
+
+	statement.Closing = statement.Opening
+	for _, transaction := range transactions {
+		switch transaction.Type {
+		case models.WalletCredit:
+			statement.Credits += transaction.Amount
+		case models.WalletDebit:
+			statement.Debits -= transaction.Amount
+		case models.WalletReversal:
+			statement.Reversals += transaction.Amount
+		case models.WalletPayroll:
+			statement.Settlements += transaction.Amount
+		default:
+			statement.Adjustments += transaction.Amount
+		}
+		statement.Closing += transaction.Amount
+	}
+	if statement.Closing < 0 {
+		statement.Payroll = -statement.Closing
+	}
+	if details {
+		statement.Transactions = transactions
+	}
+
+	return statement, nil
+}
+
+// Reads the wallet for update, creating it the first time
+func walletLock(tx *gorm.DB, userId uint64) (models.Wallet, error) {
+	var wallet models.Wallet
+	err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.Wallet{UserID: userId}).Error
+	if err == nil {
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("user_id = ?", userId).First(&wallet).Error
+	}
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", userId).Msg("Failed to lock Wallet")
+	}
+	return wallet, err
+}
+
+// Adds the transaction to the ledger and updates the balance - debits cannot go over the credit limit
+func walletPost(tx *gorm.DB, transaction models.WalletTransaction) (models.WalletTransaction, error) {
+	wallet, err := walletLock(tx, transaction.UserID)
+	if err != nil {
+		return transaction, err
+	}
+
+	transaction.Balance = wallet.Balance + transaction.Amount
+	if transaction.Type == models.WalletDebit && wallet.CreditLimit != nil && transaction.Balance < -*wallet.CreditLimit {
+		return transaction, validationErrorf("meal wallet credit limit of %s reached - balance is %s", *wallet.CreditLimit, wallet.Balance)
+	}
+
+	err = tx.Model(&wallet).Update("balance", transaction.Balance).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", transaction.UserID).Msg("Failed to update Wallet balance")
+		return transaction, err
+	}
+
+	err = tx.Create(&transaction).Error
+	if err != nil {
+		log.Error().Err(err).Interface("transaction", transaction).Msg("Failed to create Wallet transaction")
+	}
+	return transaction, err
+}
+
+// Employees of a company pay their orders with the meal wallet
+func walletUser(tx *gorm.DB, userId uint64) (bool, error) {
+	var user models.User
+	err := tx.Select("company_id").First(&user, userId).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", userId).Msg("Failed to read User company")
+	}
+	return user.CompanyID > 0, err
+}
+
+// Charges the cost to pay of the order to the wallet of the employee, when it is confirmed
+func orderWalletCharge(tx *gorm.DB, order models.Order) error {
+	if order.CostToPay <= 0 || order.PaymentStatus != models.PaymentUnpaid {
+		return nil
+	}
+
+	_, err := walletPost(tx, models.WalletTransaction{UserID: order.UserID, OrderID: order.ID, Type: models.WalletDebit,
+		Amount: -order.CostToPay, Description: fmt.Sprintf("order %d", order.ID)})
+	if err != nil {
+		return err
+	}
+
+	err = tx.Model(&order).Updates(map[string]interface{}{
+		"payment_method":   models.PaymentMethodWallet,
+		"payment_status":   models.PaymentCaptured,
+		"payment_amount":   order.CostToPay,
+		"payment_refunded": models.Money(0),
+	}).Error
+	if err != nil {
+		log.Error().Err(err).Uint64("orderId", order.ID).Msg("Failed to save order wallet payment")
+	}
+	return err
+}
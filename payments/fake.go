@@ -0,0 +1,96 @@
+package payments
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"tfm_backend/models"
+
+	"github.com/rs/zerolog/log"
+)
+
+const fakePrefix = "fake_"
+
+// Provider for development - every payment is authorized and nothing is charged.
+// Webhooks are signed with HMAC-SHA256 of the body, in hex.
+type FakeProvider struct {
+	webhookSecret string
+}
+
+func NewFakeProvider(webhookSecret string) *FakeProvider {
+	return &FakeProvider{webhookSecret: webhookSecret}
+}
+
+func (p *FakeProvider) Name() string {
+	return "fake"
+}
+
+func (p *FakeProvider) Authorize(amount models.Money, reference string) (Payment, error) {
+	if amount <= 0 {
+		return Payment{}, errors.New("payments need a positive amount")
+	}
+
+	id := make([]byte, 12)
+	secret := make([]byte, 12)
+	_, err := rand.Read(id)
+	if err == nil {
+		_, err = rand.Read(secret)
+	}
+	if err != nil {
+		return Payment{}, err
+	}
+
+	payment := Payment{ID: fakePrefix + hex.EncodeToString(id), Status: models.PaymentAuthorized}
+	payment.ClientSecret = payment.ID + "_secret_" + hex.EncodeToString(secret)
+	log.Info().Str("paymentId", payment.ID).Str("reference", reference).Stringer("amount", amount).Msg("Fake payment authorized")
+	return payment, nil
+}
+
+func (p *FakeProvider) Capture(paymentId string, amount models.Money) error {
+	if !strings.HasPrefix(paymentId, fakePrefix) {
+		return fmt.Errorf("unknown payment %s", paymentId)
+	}
+
+	log.Info().Str("paymentId", paymentId).Stringer("amount", amount).Msg("Fake payment captured")
+	return nil
+}
+
+func (p *FakeProvider) Refund(paymentId string, amount models.Money, idempotencyKey string) error {
+	if !strings.HasPrefix(paymentId, fakePrefix) {
+		return fmt.Errorf("unknown payment %s", paymentId)
+	}
+
+	log.Info().Str("paymentId", paymentId).Stringer("amount", amount).Str("idempotencyKey", idempotencyKey).Msg("Fake payment refunded")
+	return nil
+}
+
+func (p *FakeProvider) VerifyWebhook(payload []byte, signature string) (Event, error) {
+	var event Event
+
+	mac := hmac.New(sha256.New, []byte(p.webhookSecret))
+	mac.Write(payload)
+	expected := mac.Sum(nil)
+	received, err := hex.DecodeString(signature)
+	if err != nil || len(p.webhookSecret) == 0 || !hmac.Equal(expected, received) {
+		return event, ErrInvalidSignature
+	}
+
+	err = json.Unmarshal(payload, &event)
+	if err != nil {
+		return event, err
+	}
+
+	switch event.Status {
+	case models.PaymentAuthorized, models.PaymentCaptured, models.PaymentRefunded, models.PaymentFailed:
+	default:
+		return event, fmt.Errorf("unknown payment status %s", event.Status)
+	}
+
+	return event, nil
+}
@@ -0,0 +1,45 @@
+package payments
+
+import (
+	"errors"
+	"fmt"
+
+	"tfm_backend/models"
+)
+
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// Payment service used to charge the orders
+type Provider interface {
+	Name() string
+	// Holds the amount on the customer's payment method
+	Authorize(amount models.Money, reference string) (Payment, error)
+	// Charges an authorized payment - the amount can be less than authorized, the rest is released
+	Capture(paymentId string, amount models.Money) error
+	// Gives back part of the payment, or releases it when it was not captured yet.
+	// Retries with the same idempotency key don't give it back twice.
+	Refund(paymentId string, amount models.Money, idempotencyKey string) error
+	// Checks the signature of a notification sent by the provider and decodes it
+	VerifyWebhook(payload []byte, signature string) (Event, error)
+}
+
+type Payment struct {
+	ID           string
+	ClientSecret string // the frontend confirms the payment with it
+	Status       string // models.PaymentAuthorized, or models.PaymentFailed
+}
+
+// Change of the status of a payment, notified by the provider
+type Event struct {
+	PaymentID string `json:"paymentId"`
+	Status    string `json:"status"`
+}
+
+func NewProvider(cfg models.ConfigPayments) (Provider, error) {
+	switch cfg.Provider {
+	case "", "fake":
+		return NewFakeProvider(cfg.WebhookSecret), nil
+	}
+
+	return nil, fmt.Errorf("unknown payment provider %s", cfg.Provider)
+}
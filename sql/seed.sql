@@ -12,7 +12,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'eggs' and d.name = 'Tex mex breakfast specialty' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Tex mex breakfast specialty' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Tex mex breakfast specialty' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Tex mex breakfast specialty' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, updated_at) VALUES ('Steak and eggs specialty', 'Your choice of steak served with 0 eggs and home fries served with choice of roast and side', 22.59 
@@ -20,7 +20,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'eggs' and d.name = 'Steak and eggs specialty' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Steak and eggs specialty' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Steak and eggs specialty' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Steak and eggs specialty' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, updated_at) VALUES ('Breakfast burrito', 'Stuffed with rice black beans avocado tomatoes cheddar cheese cilantro and jalapenos served with sour cream', 14.79 
@@ -28,7 +28,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'eggs' and d.name = 'Breakfast burrito' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Breakfast burrito' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Breakfast burrito' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Breakfast burrito' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, updated_at) VALUES ('Carnitas and huevos', 'Slow roasted pork shoulder 0 eggs topped with grilled peppers and onions served with choice of toast and side', 13.99 
@@ -36,7 +36,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'eggs' and d.name = 'Carnitas and huevos' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Carnitas and huevos' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Carnitas and huevos' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Carnitas and huevos' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, updated_at) VALUES ('Rolled omelette', 'A lightly done plain 0 egg omelet stuffed and covered with your choice of mix ins not served with a side', 12.99 
@@ -44,7 +44,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'eggs' and d.name = 'Rolled omelette' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Rolled omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Rolled omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Rolled omelette' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, updated_at) VALUES ('Athenian omelette', 'Spinach feta and onions', 12.19 
@@ -52,7 +52,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'eggs' and d.name = 'Athenian omelette' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Athenian omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Athenian omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Athenian omelette' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, updated_at) VALUES ('Cobb omelette', 'Avocado bacon and cheddar cheese', 12.99 
@@ -60,7 +60,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'eggs' and d.name = 'Cobb omelette' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cobb omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cobb omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Cobb omelette' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, updated_at) VALUES ('Farmer s omelette', 'Ham bacon and sausage', 13.49 
@@ -68,7 +68,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'eggs' and d.name = 'Farmer s omelette' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Farmer s omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Farmer s omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Farmer s omelette' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, updated_at) VALUES ('Mexican omelette', 'Cheddar cheese and jalapeno peppers', 11.49 
@@ -76,7 +76,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'eggs' and d.name = 'Mexican omelette' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Mexican omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Mexican omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Mexican omelette' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, updated_at) VALUES ('Spanish omelette', 'Topped and stuffed with salsa', 11.49 
@@ -84,7 +84,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'eggs' and d.name = 'Spanish omelette' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Spanish omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Spanish omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Spanish omelette' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, updated_at) VALUES ('Western omelette', 'Ham pepper and onions', 11.99 
@@ -92,7 +92,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'eggs' and d.name = 'Western omelette' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Western omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Western omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Western omelette' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, updated_at) VALUES ('Greek omelette', 'Tomatoes feta cheese and onions', 12.19 
@@ -100,7 +100,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'eggs' and d.name = 'Greek omelette' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Greek omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Greek omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Greek omelette' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, updated_at) VALUES ('Cheese omelette', 'Served with your choice of cheese', 11.49 
@@ -108,7 +108,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'eggs' and d.name = 'Cheese omelette' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cheese omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cheese omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Cheese omelette' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, updated_at) VALUES ('Super omelette', 'Mushrooms bacon tomatoes peppers onions and mozzarella cheese', 15.39 
@@ -116,7 +116,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'eggs' and d.name = 'Super omelette' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Super omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Super omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Super omelette' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, updated_at) VALUES ('The astorian omelette', 'Charred beef sausage fresh herbs tomatoes mixed with 0 eggs topped with hollandaise sauce over a toasted roll with steak fries', 15.79 
@@ -124,7 +124,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'eggs' and d.name = 'The astorian omelette' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'The astorian omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'The astorian omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'The astorian omelette' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, updated_at) VALUES ('Build your own omelette', 'Build it how you like', 10.99 
@@ -132,7 +132,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'eggs' and d.name = 'Build your own omelette' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Build your own omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Build your own omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Build your own omelette' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, updated_at) VALUES ('Leo omelette', 'Nova scotia lox and onions', 17.39 
@@ -140,7 +140,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'eggs' and d.name = 'Leo omelette' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Leo omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Leo omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Leo omelette' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, updated_at) VALUES ('Flank steak philly omelette', 'Flank steak onions mushrooms and cheddar cheese', 18.89 
@@ -148,7 +148,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'eggs' and d.name = 'Flank steak philly omelette' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Flank steak philly omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Flank steak philly omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Flank steak philly omelette' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, updated_at) VALUES ('Garden omelette', 'Broccoli mushrooms onions and tomatoes', 11.89 
@@ -156,7 +156,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'eggs' and d.name = 'Garden omelette' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Garden omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Garden omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Garden omelette' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, updated_at) VALUES ('Portobello omelette', 'Grilled portobello mushrooms red peppers and mozzarella cheese', 14.39 
@@ -164,7 +164,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'eggs' and d.name = 'Portobello omelette' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Portobello omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Portobello omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Portobello omelette' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, updated_at) VALUES ('Italian omelette', 'Sausage peppers onions and mozzarella cheese', 13.19 
@@ -172,7 +172,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'eggs' and d.name = 'Italian omelette' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Italian omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Italian omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Italian omelette' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, updated_at) VALUES ('Hangover omelette', 'Ground beef steak fries all the cheese topped with gravy', 15.99 
@@ -180,7 +180,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'eggs' and d.name = 'Hangover omelette' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hangover omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hangover omelette' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Hangover omelette' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, updated_at) VALUES ('Country style skillet', 'Home fries ham bacon sausage roasted peppers and 0 eggs', 14.69 
@@ -198,9 +198,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Classic monte cristo' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Classic monte cristo' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Classic monte cristo' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Classic monte cristo' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Classic monte cristo' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Classic monte cristo' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -212,9 +212,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'California tofu breakfast' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'California tofu breakfast' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'California tofu breakfast' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'California tofu breakfast' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'California tofu breakfast' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'California tofu breakfast' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -226,9 +226,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Not your average breakfast sammy' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Not your average breakfast sammy' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Not your average breakfast sammy' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Not your average breakfast sammy' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Not your average breakfast sammy' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Not your average breakfast sammy' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -240,9 +240,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Pancake sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Pancake sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Pancake sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Pancake sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Pancake sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Pancake sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -254,9 +254,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Bruschetta breakfast sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bruschetta breakfast sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bruschetta breakfast sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bruschetta breakfast sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bruschetta breakfast sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Bruschetta breakfast sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -268,11 +268,11 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('desserts', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'desserts' and d.name = 'Red velvet pancakes' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('sugar', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Red velvet pancakes' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Red velvet pancakes' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Red velvet pancakes' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Red velvet pancakes' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Red velvet pancakes' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Red velvet pancakes' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Red velvet pancakes' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -284,11 +284,11 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('desserts', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'desserts' and d.name = 'Silver dollar pancakes' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('sugar', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Silver dollar pancakes' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Silver dollar pancakes' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Silver dollar pancakes' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Silver dollar pancakes' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Silver dollar pancakes' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Silver dollar pancakes' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Silver dollar pancakes' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -300,11 +300,11 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('desserts', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'desserts' and d.name = 'Give me s more cakes' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('sugar', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Give me s more cakes' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Give me s more cakes' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Give me s more cakes' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Give me s more cakes' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Give me s more cakes' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Give me s more cakes' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Give me s more cakes' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -316,11 +316,11 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('desserts', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'desserts' and d.name = 'Healthy fruity whole grain pancakes' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('sugar', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Healthy fruity whole grain pancakes' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Healthy fruity whole grain pancakes' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Healthy fruity whole grain pancakes' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Healthy fruity whole grain pancakes' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Healthy fruity whole grain pancakes' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Healthy fruity whole grain pancakes' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Healthy fruity whole grain pancakes' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -332,11 +332,11 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('desserts', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'desserts' and d.name = 'Gluten free pancakes' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('sugar', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Gluten free pancakes' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Gluten free pancakes' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Gluten free pancakes' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Gluten free pancakes' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Gluten free pancakes' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Gluten free pancakes' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Gluten free pancakes' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -348,11 +348,11 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('desserts', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'desserts' and d.name = 'Homemade waffle' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('sugar', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Homemade waffle' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Homemade waffle' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Homemade waffle' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Homemade waffle' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Homemade waffle' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Homemade waffle' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Homemade waffle' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -364,11 +364,11 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('desserts', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'desserts' and d.name = 'Chicken waffles' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('sugar', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chicken waffles' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chicken waffles' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chicken waffles' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chicken waffles' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chicken waffles' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chicken waffles' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Chicken waffles' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -380,11 +380,11 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('desserts', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'desserts' and d.name = 'Waffle bowl' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('sugar', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Waffle bowl' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Waffle bowl' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Waffle bowl' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Waffle bowl' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Waffle bowl' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Waffle bowl' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Waffle bowl' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -396,11 +396,11 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('desserts', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'desserts' and d.name = 'Bacon waffles' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('sugar', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bacon waffles' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bacon waffles' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bacon waffles' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bacon waffles' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bacon waffles' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bacon waffles' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Bacon waffles' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -412,11 +412,11 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('desserts', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'desserts' and d.name = 'Usa waffle' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('sugar', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Usa waffle' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Usa waffle' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Usa waffle' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Usa waffle' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Usa waffle' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Usa waffle' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Usa waffle' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -428,11 +428,11 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('desserts', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'desserts' and d.name = 'Jumbo challah french toast' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('sugar', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Jumbo challah french toast' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Jumbo challah french toast' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Jumbo challah french toast' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Jumbo challah french toast' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Jumbo challah french toast' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Jumbo challah french toast' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Jumbo challah french toast' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -444,11 +444,11 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('desserts', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'desserts' and d.name = 'Deep fried french toast sticks' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('sugar', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deep fried french toast sticks' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deep fried french toast sticks' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deep fried french toast sticks' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deep fried french toast sticks' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deep fried french toast sticks' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deep fried french toast sticks' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Deep fried french toast sticks' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -460,11 +460,11 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('desserts', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'desserts' and d.name = 'Chocolate banana pb and j french toast' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('sugar', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chocolate banana pb and j french toast' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chocolate banana pb and j french toast' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chocolate banana pb and j french toast' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chocolate banana pb and j french toast' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chocolate banana pb and j french toast' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chocolate banana pb and j french toast' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Chocolate banana pb and j french toast' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1062,9 +1062,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Barbecue burger' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Barbecue burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Barbecue burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Barbecue burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Barbecue burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Barbecue burger' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1076,9 +1076,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Deluxe barbecue burger' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe barbecue burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe barbecue burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe barbecue burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe barbecue burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Deluxe barbecue burger' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1090,9 +1090,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Mexican burger' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Mexican burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Mexican burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Mexican burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Mexican burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Mexican burger' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1104,9 +1104,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Deluxe mexican burger' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe mexican burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe mexican burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe mexican burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe mexican burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Deluxe mexican burger' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1118,9 +1118,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Pizza burger' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Pizza burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Pizza burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Pizza burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Pizza burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Pizza burger' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1132,9 +1132,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Deluxe pizza burger' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe pizza burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe pizza burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe pizza burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe pizza burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Deluxe pizza burger' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1146,9 +1146,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Cheese destruction burger' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cheese destruction burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cheese destruction burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cheese destruction burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cheese destruction burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Cheese destruction burger' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1160,9 +1160,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Deluxe cheese destruction burger' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe cheese destruction burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe cheese destruction burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe cheese destruction burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe cheese destruction burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Deluxe cheese destruction burger' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1174,9 +1174,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Broadway burger' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Broadway burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Broadway burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Broadway burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Broadway burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Broadway burger' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1188,9 +1188,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Deluxe broadway burger' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe broadway burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe broadway burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe broadway burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe broadway burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Deluxe broadway burger' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1202,9 +1202,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Jumbo california vegan burger' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Jumbo california vegan burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Jumbo california vegan burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Jumbo california vegan burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Jumbo california vegan burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Jumbo california vegan burger' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1216,9 +1216,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Sweet sriracha bison burger' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Sweet sriracha bison burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Sweet sriracha bison burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Sweet sriracha bison burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Sweet sriracha bison burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Sweet sriracha bison burger' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1230,9 +1230,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Cobb burger' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cobb burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cobb burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cobb burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cobb burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Cobb burger' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1244,9 +1244,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Deluxe cobb burger' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe cobb burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe cobb burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe cobb burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe cobb burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Deluxe cobb burger' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1258,9 +1258,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Italiano burger' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Italiano burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Italiano burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Italiano burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Italiano burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Italiano burger' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1272,9 +1272,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Pulled pork burger' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Pulled pork burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Pulled pork burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Pulled pork burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Pulled pork burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Pulled pork burger' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1286,9 +1286,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Gorilla burger' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Gorilla burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Gorilla burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Gorilla burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Gorilla burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Gorilla burger' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1300,9 +1300,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Burger melt' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Burger melt' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Burger melt' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Burger melt' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Burger melt' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Burger melt' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1314,9 +1314,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Deluxe burger melt' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe burger melt' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe burger melt' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe burger melt' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe burger melt' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Deluxe burger melt' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1328,9 +1328,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Bacon mac and cheese burger' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bacon mac and cheese burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bacon mac and cheese burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bacon mac and cheese burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bacon mac and cheese burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Bacon mac and cheese burger' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1342,9 +1342,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Deluxe bacon mac and cheese burger' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe bacon mac and cheese burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe bacon mac and cheese burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe bacon mac and cheese burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe bacon mac and cheese burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Deluxe bacon mac and cheese burger' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1356,9 +1356,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Burger bar build your own burger' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Burger bar build your own burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Burger bar build your own burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Burger bar build your own burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Burger bar build your own burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Burger bar build your own burger' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1370,9 +1370,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Deluxe burger bar build your own burger' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe burger bar build your own burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe burger bar build your own burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe burger bar build your own burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deluxe burger bar build your own burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Deluxe burger bar build your own burger' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1384,9 +1384,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Classic bel aire sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Classic bel aire sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Classic bel aire sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Classic bel aire sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Classic bel aire sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Classic bel aire sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1398,9 +1398,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Parmigiana bel aire sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Parmigiana bel aire sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Parmigiana bel aire sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Parmigiana bel aire sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Parmigiana bel aire sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Parmigiana bel aire sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1412,9 +1412,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Italiano bel aire sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Italiano bel aire sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Italiano bel aire sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Italiano bel aire sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Italiano bel aire sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Italiano bel aire sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1426,9 +1426,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Teriyaki bel aire sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Teriyaki bel aire sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Teriyaki bel aire sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Teriyaki bel aire sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Teriyaki bel aire sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Teriyaki bel aire sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1440,9 +1440,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Cobb bel aire sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cobb bel aire sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cobb bel aire sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cobb bel aire sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cobb bel aire sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Cobb bel aire sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1454,9 +1454,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Caesar bel aire sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Caesar bel aire sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Caesar bel aire sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Caesar bel aire sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Caesar bel aire sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Caesar bel aire sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1468,9 +1468,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Greek bel aire sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Greek bel aire sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Greek bel aire sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Greek bel aire sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Greek bel aire sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Greek bel aire sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1482,9 +1482,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Riverview bel aire sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Riverview bel aire sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Riverview bel aire sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Riverview bel aire sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Riverview bel aire sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Riverview bel aire sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1496,9 +1496,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Southwestern bel aire sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Southwestern bel aire sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Southwestern bel aire sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Southwestern bel aire sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Southwestern bel aire sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Southwestern bel aire sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1510,9 +1510,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Monster bel aire sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Monster bel aire sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Monster bel aire sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Monster bel aire sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Monster bel aire sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Monster bel aire sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1524,9 +1524,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Chicken fajita bel aire sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chicken fajita bel aire sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chicken fajita bel aire sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chicken fajita bel aire sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chicken fajita bel aire sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Chicken fajita bel aire sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1538,9 +1538,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Tex mex bel aire sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Tex mex bel aire sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Tex mex bel aire sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Tex mex bel aire sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Tex mex bel aire sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Tex mex bel aire sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1552,9 +1552,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Bbq bacon ranch bel aire sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bbq bacon ranch bel aire sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bbq bacon ranch bel aire sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bbq bacon ranch bel aire sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bbq bacon ranch bel aire sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Bbq bacon ranch bel aire sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1566,9 +1566,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Southern comfort bel aire sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Southern comfort bel aire sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Southern comfort bel aire sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Southern comfort bel aire sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Southern comfort bel aire sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Southern comfort bel aire sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1580,9 +1580,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Ballpark dog' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Ballpark dog' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Ballpark dog' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Ballpark dog' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Ballpark dog' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Ballpark dog' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1594,9 +1594,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Mac and cheese dog' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Mac and cheese dog' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Mac and cheese dog' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Mac and cheese dog' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Mac and cheese dog' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Mac and cheese dog' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1608,9 +1608,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Pulled dog' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Pulled dog' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Pulled dog' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Pulled dog' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Pulled dog' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Pulled dog' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1622,9 +1622,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Plain hot dog' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Plain hot dog' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Plain hot dog' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Plain hot dog' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Plain hot dog' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Plain hot dog' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1636,9 +1636,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Surf dog' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Surf dog' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Surf dog' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Surf dog' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Surf dog' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Surf dog' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1650,9 +1650,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Bruschetta dog' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bruschetta dog' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bruschetta dog' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bruschetta dog' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bruschetta dog' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Bruschetta dog' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1664,9 +1664,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Shrimp and avocado sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Shrimp and avocado sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Shrimp and avocado sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Shrimp and avocado sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Shrimp and avocado sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Shrimp and avocado sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1678,9 +1678,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Ham and cheese sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Ham and cheese sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Ham and cheese sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Ham and cheese sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Ham and cheese sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Ham and cheese sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1692,9 +1692,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Classic blt sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Classic blt sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Classic blt sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Classic blt sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Classic blt sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Classic blt sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1706,9 +1706,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Tuna milanese' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Tuna milanese' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Tuna milanese' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Tuna milanese' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Tuna milanese' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Tuna milanese' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1720,9 +1720,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Homemade salad sandwich tuna egg chicken or shrimp salad' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Homemade salad sandwich tuna egg chicken or shrimp salad' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Homemade salad sandwich tuna egg chicken or shrimp salad' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Homemade salad sandwich tuna egg chicken or shrimp salad' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Homemade salad sandwich tuna egg chicken or shrimp salad' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Homemade salad sandwich tuna egg chicken or shrimp salad' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1734,9 +1734,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Bbq bacon chicken salad sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bbq bacon chicken salad sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bbq bacon chicken salad sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bbq bacon chicken salad sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bbq bacon chicken salad sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Bbq bacon chicken salad sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1748,9 +1748,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'French shrimp salad sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'French shrimp salad sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'French shrimp salad sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'French shrimp salad sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'French shrimp salad sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'French shrimp salad sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1762,9 +1762,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Mediterranean tuna sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Mediterranean tuna sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Mediterranean tuna sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Mediterranean tuna sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Mediterranean tuna sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Mediterranean tuna sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1776,9 +1776,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Classic diner grilled cheese sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Classic diner grilled cheese sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Classic diner grilled cheese sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Classic diner grilled cheese sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Classic diner grilled cheese sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Classic diner grilled cheese sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1790,9 +1790,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Strawberry avocado and cheddar sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Strawberry avocado and cheddar sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Strawberry avocado and cheddar sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Strawberry avocado and cheddar sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Strawberry avocado and cheddar sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Strawberry avocado and cheddar sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1804,9 +1804,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Bacon apple and muenster sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bacon apple and muenster sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bacon apple and muenster sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bacon apple and muenster sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bacon apple and muenster sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Bacon apple and muenster sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1818,9 +1818,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Loaded mac and cheese sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Loaded mac and cheese sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Loaded mac and cheese sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Loaded mac and cheese sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Loaded mac and cheese sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Loaded mac and cheese sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1916,9 +1916,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Philly cheese steak sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Philly cheese steak sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Philly cheese steak sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Philly cheese steak sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Philly cheese steak sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Philly cheese steak sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1930,9 +1930,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Reuben sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Reuben sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Reuben sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Reuben sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Reuben sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Reuben sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1944,9 +1944,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Pastrami sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Pastrami sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Pastrami sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Pastrami sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Pastrami sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Pastrami sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1958,9 +1958,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Corned beef sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Corned beef sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Corned beef sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Corned beef sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Corned beef sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Corned beef sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1972,9 +1972,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Brooklyn avenue sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Brooklyn avenue sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Brooklyn avenue sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Brooklyn avenue sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Brooklyn avenue sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Brooklyn avenue sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -1986,9 +1986,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Roasted turkey sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Roasted turkey sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Roasted turkey sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Roasted turkey sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Roasted turkey sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Roasted turkey sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2000,9 +2000,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Roast beef sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Roast beef sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Roast beef sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Roast beef sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Roast beef sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Roast beef sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2014,9 +2014,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Astoria heights sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Astoria heights sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Astoria heights sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Astoria heights sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Astoria heights sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Astoria heights sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2028,9 +2028,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Silver cup sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Silver cup sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Silver cup sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Silver cup sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Silver cup sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Silver cup sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2042,9 +2042,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'French dip sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'French dip sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'French dip sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'French dip sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'French dip sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'French dip sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2056,9 +2056,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Southern style brisket sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Southern style brisket sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Southern style brisket sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Southern style brisket sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Southern style brisket sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Southern style brisket sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2070,9 +2070,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Brisket sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Brisket sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Brisket sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Brisket sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Brisket sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Brisket sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2084,9 +2084,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Pulled pork sandwiches' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Pulled pork sandwiches' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Pulled pork sandwiches' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Pulled pork sandwiches' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Pulled pork sandwiches' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Pulled pork sandwiches' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2098,9 +2098,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Homemade meatloaf sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Homemade meatloaf sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Homemade meatloaf sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Homemade meatloaf sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Homemade meatloaf sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Homemade meatloaf sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2112,9 +2112,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Thanksgiving turkey sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Thanksgiving turkey sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Thanksgiving turkey sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Thanksgiving turkey sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Thanksgiving turkey sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Thanksgiving turkey sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2126,9 +2126,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Cuban sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cuban sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cuban sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cuban sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cuban sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Cuban sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2140,9 +2140,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Habitat sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Habitat sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Habitat sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Habitat sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Habitat sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Habitat sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2154,9 +2154,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Hot open pulled pork sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hot open pulled pork sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hot open pulled pork sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hot open pulled pork sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hot open pulled pork sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Hot open pulled pork sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2168,9 +2168,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Hot open skirt steak sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hot open skirt steak sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hot open skirt steak sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hot open skirt steak sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hot open skirt steak sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Hot open skirt steak sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2182,9 +2182,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Hot open turkey sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hot open turkey sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hot open turkey sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hot open turkey sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hot open turkey sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Hot open turkey sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2196,9 +2196,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Hot open roast sirloin sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hot open roast sirloin sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hot open roast sirloin sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hot open roast sirloin sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hot open roast sirloin sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Hot open roast sirloin sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2210,9 +2210,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Gyro sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Gyro sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Gyro sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Gyro sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Gyro sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Gyro sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2224,9 +2224,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Roasted lamb pita' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Roasted lamb pita' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Roasted lamb pita' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Roasted lamb pita' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Roasted lamb pita' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Roasted lamb pita' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2238,9 +2238,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Gyro platter' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Gyro platter' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Gyro platter' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Gyro platter' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Gyro platter' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Gyro platter' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2252,9 +2252,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Souvlaki platter' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Souvlaki platter' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Souvlaki platter' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Souvlaki platter' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Souvlaki platter' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Souvlaki platter' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2266,9 +2266,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Saganaki pita' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Saganaki pita' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Saganaki pita' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Saganaki pita' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Saganaki pita' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Saganaki pita' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2280,9 +2280,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Souvlaki sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Souvlaki sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Souvlaki sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Souvlaki sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Souvlaki sandwich' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Souvlaki sandwich' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2487,8 +2487,8 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 , 64, 41, current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('seafood & fish', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'seafood & fish' and d.name = 'Spanish paella' ON CONFLICT DO NOTHING;
-INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('seafood', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Spanish paella' AND a.name = 'seafood' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('crustaceans', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Spanish paella' AND a.name = 'crustaceans' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('fish', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Spanish paella' AND i.name = 'fish' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('green', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2505,8 +2505,8 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 , 78, 20, current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('seafood & fish', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'seafood & fish' and d.name = 'Bluefish provencal' ON CONFLICT DO NOTHING;
-INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('seafood', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bluefish provencal' AND a.name = 'seafood' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('fish', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bluefish provencal' AND a.name = 'fish' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('fish', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Bluefish provencal' AND i.name = 'fish' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('green', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2523,8 +2523,8 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 , 26, 34, current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('seafood & fish', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'seafood & fish' and d.name = 'Shrimp stir fry' ON CONFLICT DO NOTHING;
-INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('seafood', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Shrimp stir fry' AND a.name = 'seafood' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('crustaceans', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Shrimp stir fry' AND a.name = 'crustaceans' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('fish', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Shrimp stir fry' AND i.name = 'fish' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('green', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2541,8 +2541,8 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 , 97, 35, current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('seafood & fish', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'seafood & fish' and d.name = 'Mariscos portuguese' ON CONFLICT DO NOTHING;
-INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('seafood', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Mariscos portuguese' AND a.name = 'seafood' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('crustaceans', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Mariscos portuguese' AND a.name = 'crustaceans' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('fish', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Mariscos portuguese' AND i.name = 'fish' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('green', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2559,8 +2559,8 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 , 92, 82, current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('seafood & fish', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'seafood & fish' and d.name = 'Filet porto fino' ON CONFLICT DO NOTHING;
-INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('seafood', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Filet porto fino' AND a.name = 'seafood' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('fish', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Filet porto fino' AND a.name = 'fish' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('fish', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Filet porto fino' AND i.name = 'fish' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('green', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2577,8 +2577,8 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 , 17, 18, current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('seafood & fish', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'seafood & fish' and d.name = 'Sizzling salmon kebab' ON CONFLICT DO NOTHING;
-INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('seafood', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Sizzling salmon kebab' AND a.name = 'seafood' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('fish', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Sizzling salmon kebab' AND a.name = 'fish' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('fish', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Sizzling salmon kebab' AND i.name = 'fish' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('green', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2595,8 +2595,8 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 , 70, 59, current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('seafood & fish', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'seafood & fish' and d.name = 'Spicy seafood fra diavolo' ON CONFLICT DO NOTHING;
-INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('seafood', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Spicy seafood fra diavolo' AND a.name = 'seafood' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('crustaceans', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Spicy seafood fra diavolo' AND a.name = 'crustaceans' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('fish', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Spicy seafood fra diavolo' AND i.name = 'fish' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('green', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2613,8 +2613,8 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 , 90, 57, current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('seafood & fish', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'seafood & fish' and d.name = 'Linguini with clam sauce' ON CONFLICT DO NOTHING;
-INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('seafood', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Linguini with clam sauce' AND a.name = 'seafood' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('molluscs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Linguini with clam sauce' AND a.name = 'molluscs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('fish', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Linguini with clam sauce' AND i.name = 'fish' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('green', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2631,8 +2631,8 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 , 70, 3, current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('seafood & fish', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'seafood & fish' and d.name = 'Mussels marengo' ON CONFLICT DO NOTHING;
-INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('seafood', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Mussels marengo' AND a.name = 'seafood' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('molluscs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Mussels marengo' AND a.name = 'molluscs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('fish', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Mussels marengo' AND i.name = 'fish' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('green', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2770,9 +2770,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('pasta', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'pasta' and d.name = 'Fettucini alfredo' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('milk', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Fettucini alfredo' AND a.name = 'milk' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Fettucini alfredo' AND a.name = 'milk' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Fettucini alfredo' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Fettucini alfredo' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('pasta', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Fettucini alfredo' AND i.name = 'pasta' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('cheese', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2786,9 +2786,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('pasta', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'pasta' and d.name = 'Mushroom florentine' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('milk', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Mushroom florentine' AND a.name = 'milk' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Mushroom florentine' AND a.name = 'milk' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Mushroom florentine' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Mushroom florentine' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('pasta', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Mushroom florentine' AND i.name = 'pasta' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('cheese', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2802,9 +2802,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('pasta', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'pasta' and d.name = 'Penne a la greca' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('milk', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Penne a la greca' AND a.name = 'milk' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Penne a la greca' AND a.name = 'milk' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Penne a la greca' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Penne a la greca' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('pasta', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Penne a la greca' AND i.name = 'pasta' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('cheese', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2818,9 +2818,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('pasta', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'pasta' and d.name = 'Linguini italiano' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('milk', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Linguini italiano' AND a.name = 'milk' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Linguini italiano' AND a.name = 'milk' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Linguini italiano' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Linguini italiano' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('pasta', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Linguini italiano' AND i.name = 'pasta' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('cheese', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2834,9 +2834,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('pasta', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'pasta' and d.name = 'Angel hair primavera' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('milk', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Angel hair primavera' AND a.name = 'milk' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Angel hair primavera' AND a.name = 'milk' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Angel hair primavera' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Angel hair primavera' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('pasta', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Angel hair primavera' AND i.name = 'pasta' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('cheese', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2850,9 +2850,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('pasta', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'pasta' and d.name = 'Penne a la vodka' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('milk', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Penne a la vodka' AND a.name = 'milk' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Penne a la vodka' AND a.name = 'milk' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Penne a la vodka' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Penne a la vodka' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('pasta', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Penne a la vodka' AND i.name = 'pasta' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('cheese', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2866,9 +2866,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('pasta', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'pasta' and d.name = 'Baked penne parmigiana' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('milk', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Baked penne parmigiana' AND a.name = 'milk' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Baked penne parmigiana' AND a.name = 'milk' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Baked penne parmigiana' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Baked penne parmigiana' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('pasta', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Baked penne parmigiana' AND i.name = 'pasta' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('cheese', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2882,9 +2882,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('pasta', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'pasta' and d.name = 'Spaghetti carbonara' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('milk', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Spaghetti carbonara' AND a.name = 'milk' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Spaghetti carbonara' AND a.name = 'milk' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Spaghetti carbonara' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Spaghetti carbonara' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('pasta', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Spaghetti carbonara' AND i.name = 'pasta' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('cheese', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2898,9 +2898,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('pasta', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'pasta' and d.name = 'Ravioli' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('milk', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Ravioli' AND a.name = 'milk' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Ravioli' AND a.name = 'milk' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Ravioli' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Ravioli' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('pasta', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Ravioli' AND i.name = 'pasta' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('cheese', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2914,9 +2914,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('pasta', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'pasta' and d.name = 'Wild mushroom ravioli' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('milk', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Wild mushroom ravioli' AND a.name = 'milk' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Wild mushroom ravioli' AND a.name = 'milk' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Wild mushroom ravioli' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Wild mushroom ravioli' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('pasta', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Wild mushroom ravioli' AND i.name = 'pasta' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('cheese', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2930,7 +2930,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Charred skirt steak' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Charred skirt steak' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Charred skirt steak' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Charred skirt steak' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2948,7 +2948,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Roasted chicken' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Roasted chicken' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Roasted chicken' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Roasted chicken' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2966,7 +2966,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Charred sliced flank steak' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Charred sliced flank steak' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Charred sliced flank steak' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Charred sliced flank steak' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -2984,7 +2984,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Charred lamb chops' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Charred lamb chops' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Charred lamb chops' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Charred lamb chops' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -3002,7 +3002,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Roasted brisket of beef' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Roasted brisket of beef' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Roasted brisket of beef' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Roasted brisket of beef' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -3020,7 +3020,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Chicken kebab' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chicken kebab' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chicken kebab' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Chicken kebab' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -3038,7 +3038,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Homemade chicken pot pie' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Homemade chicken pot pie' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Homemade chicken pot pie' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Homemade chicken pot pie' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -3056,7 +3056,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Thanksgiving dinner' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Thanksgiving dinner' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Thanksgiving dinner' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Thanksgiving dinner' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -3074,7 +3074,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Bbq combo' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bbq combo' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bbq combo' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Bbq combo' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -3092,7 +3092,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Bbq ribs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bbq ribs' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bbq ribs' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Bbq ribs' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -3110,7 +3110,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Shepherd s pie' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Shepherd s pie' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Shepherd s pie' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Shepherd s pie' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -3128,7 +3128,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Homemade meatloaf' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Homemade meatloaf' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Homemade meatloaf' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Homemade meatloaf' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -3306,7 +3306,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Double stuffed giant burrito' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Double stuffed giant burrito' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Double stuffed giant burrito' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Double stuffed giant burrito' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -3324,7 +3324,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Chicken quesadilla' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chicken quesadilla' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chicken quesadilla' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Chicken quesadilla' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -3342,7 +3342,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Taco salad' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Taco salad' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Taco salad' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Taco salad' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -3360,7 +3360,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Suave steak' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Suave steak' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Suave steak' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Suave steak' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -3378,7 +3378,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Milanesa de pollo' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Milanesa de pollo' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Milanesa de pollo' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Milanesa de pollo' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -3396,7 +3396,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Cheese quesadilla' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cheese quesadilla' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cheese quesadilla' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Cheese quesadilla' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -3414,7 +3414,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Chimichangas' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chimichangas' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chimichangas' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Chimichangas' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -3432,7 +3432,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Shrimp bowl' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Shrimp bowl' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Shrimp bowl' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Shrimp bowl' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -3450,7 +3450,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Carnitas bowl' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Carnitas bowl' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Carnitas bowl' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Carnitas bowl' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -3468,7 +3468,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Lomo saltado' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Lomo saltado' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Lomo saltado' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Lomo saltado' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -3486,7 +3486,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Build your own nachos' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Build your own nachos' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Build your own nachos' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Build your own nachos' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -3738,11 +3738,11 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('desserts', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'desserts' and d.name = 'Our famous cheesecake' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('sugar', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Our famous cheesecake' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Our famous cheesecake' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Our famous cheesecake' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Our famous cheesecake' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Our famous cheesecake' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Our famous cheesecake' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Our famous cheesecake' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -3754,11 +3754,11 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('desserts', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'desserts' and d.name = 'Chocolate cheesecake' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('sugar', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chocolate cheesecake' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chocolate cheesecake' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chocolate cheesecake' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chocolate cheesecake' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chocolate cheesecake' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chocolate cheesecake' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Chocolate cheesecake' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -3770,11 +3770,11 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('desserts', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'desserts' and d.name = 'Strawberry cheesecake' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('sugar', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Strawberry cheesecake' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Strawberry cheesecake' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Strawberry cheesecake' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Strawberry cheesecake' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Strawberry cheesecake' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Strawberry cheesecake' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Strawberry cheesecake' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -3786,11 +3786,11 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('desserts', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'desserts' and d.name = 'Freshly baked pies' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('sugar', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Freshly baked pies' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Freshly baked pies' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Freshly baked pies' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Freshly baked pies' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Freshly baked pies' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Freshly baked pies' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Freshly baked pies' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -3802,11 +3802,11 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('desserts', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'desserts' and d.name = 'Ice cream' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('sugar', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Ice cream' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Ice cream' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Ice cream' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Ice cream' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Ice cream' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Ice cream' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Ice cream' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -3818,11 +3818,11 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('desserts', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'desserts' and d.name = 'Pudding' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('sugar', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Pudding' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Pudding' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Pudding' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Pudding' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Pudding' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Pudding' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Pudding' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -3834,11 +3834,11 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('desserts', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'desserts' and d.name = 'Brownie sundae' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('sugar', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Brownie sundae' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Brownie sundae' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Brownie sundae' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Brownie sundae' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Brownie sundae' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Brownie sundae' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Brownie sundae' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -3850,11 +3850,11 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('desserts', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'desserts' and d.name = 'Deep fried oreos' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('sugar', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deep fried oreos' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deep fried oreos' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deep fried oreos' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deep fried oreos' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deep fried oreos' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Deep fried oreos' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Deep fried oreos' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -3866,11 +3866,11 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('desserts', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'desserts' and d.name = 'Ice cream soda' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('sugar', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Ice cream soda' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Ice cream soda' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Ice cream soda' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Ice cream soda' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Ice cream soda' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Ice cream soda' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Ice cream soda' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -3882,11 +3882,11 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('desserts', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'desserts' and d.name = 'All american' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('sugar', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'All american' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'All american' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'All american' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'All american' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'All american' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'All american' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'All american' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -3898,11 +3898,11 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('desserts', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'desserts' and d.name = 'Layer cake' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('sugar', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Layer cake' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Layer cake' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Layer cake' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Layer cake' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Layer cake' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Layer cake' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Layer cake' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -3914,11 +3914,11 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('desserts', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'desserts' and d.name = 'Homemade brownie' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('sugar', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Homemade brownie' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Homemade brownie' AND a.name = 'sugar' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Homemade brownie' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Homemade brownie' AND a.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Homemade brownie' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Homemade brownie' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('eggs', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Homemade brownie' AND i.name = 'eggs' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -4302,9 +4302,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('pizza', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'pizza' and d.name = 'Breakfast pizza' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('milk', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Breakfast pizza' AND a.name = 'milk' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Breakfast pizza' AND a.name = 'milk' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Breakfast pizza' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Breakfast pizza' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Breakfast pizza' AND i.name = 'flour' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('cheese', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -4316,9 +4316,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('pizza', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'pizza' and d.name = 'Bbq ranch chicken pizza' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('milk', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bbq ranch chicken pizza' AND a.name = 'milk' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bbq ranch chicken pizza' AND a.name = 'milk' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bbq ranch chicken pizza' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bbq ranch chicken pizza' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Bbq ranch chicken pizza' AND i.name = 'flour' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('cheese', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -4330,9 +4330,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('pizza', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'pizza' and d.name = 'Buffalo chicken pizza' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('milk', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Buffalo chicken pizza' AND a.name = 'milk' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Buffalo chicken pizza' AND a.name = 'milk' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Buffalo chicken pizza' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Buffalo chicken pizza' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Buffalo chicken pizza' AND i.name = 'flour' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('cheese', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -4344,9 +4344,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('pizza', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'pizza' and d.name = 'Meat lovers pizza' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('milk', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Meat lovers pizza' AND a.name = 'milk' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Meat lovers pizza' AND a.name = 'milk' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Meat lovers pizza' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Meat lovers pizza' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Meat lovers pizza' AND i.name = 'flour' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('cheese', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -4358,9 +4358,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('pizza', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'pizza' and d.name = 'Greek pizza' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('milk', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Greek pizza' AND a.name = 'milk' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Greek pizza' AND a.name = 'milk' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Greek pizza' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Greek pizza' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Greek pizza' AND i.name = 'flour' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('cheese', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -4372,9 +4372,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('pizza', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'pizza' and d.name = 'Brooklyn avenue pizza' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('milk', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Brooklyn avenue pizza' AND a.name = 'milk' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Brooklyn avenue pizza' AND a.name = 'milk' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Brooklyn avenue pizza' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Brooklyn avenue pizza' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Brooklyn avenue pizza' AND i.name = 'flour' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('cheese', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -4386,9 +4386,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('pizza', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'pizza' and d.name = 'Hangover pizza' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('milk', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hangover pizza' AND a.name = 'milk' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hangover pizza' AND a.name = 'milk' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hangover pizza' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hangover pizza' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('flour', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Hangover pizza' AND i.name = 'flour' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('cheese', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -4834,7 +4834,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Gyro sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Gyro sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Gyro sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Gyro sandwich' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -4852,7 +4852,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Souvlaki sandwich' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Souvlaki sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Souvlaki sandwich' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Souvlaki sandwich' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -4870,7 +4870,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Chipotle gyro' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chipotle gyro' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chipotle gyro' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Chipotle gyro' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -4888,7 +4888,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Chipotle souvlaki' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chipotle souvlaki' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chipotle souvlaki' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Chipotle souvlaki' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -4906,7 +4906,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Russian gyro' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Russian gyro' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Russian gyro' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Russian gyro' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -4924,7 +4924,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Russian souvlaki' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Russian souvlaki' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Russian souvlaki' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Russian souvlaki' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -4942,7 +4942,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Mega gyro' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Mega gyro' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Mega gyro' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Mega gyro' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -4960,7 +4960,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Mega souvlaki' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Mega souvlaki' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Mega souvlaki' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Mega souvlaki' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -4978,7 +4978,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Athenian gyro' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Athenian gyro' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Athenian gyro' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Athenian gyro' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -4996,7 +4996,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Athenian souvlaki' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Athenian souvlaki' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Athenian souvlaki' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Athenian souvlaki' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5014,7 +5014,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Extreme gyro' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Extreme gyro' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Extreme gyro' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Extreme gyro' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5032,7 +5032,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Extreme souvlaki' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Extreme souvlaki' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Extreme souvlaki' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Extreme souvlaki' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5050,7 +5050,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Buffalo gyro' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Buffalo gyro' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Buffalo gyro' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Buffalo gyro' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5068,7 +5068,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Buffalo souvlaki' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Buffalo souvlaki' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Buffalo souvlaki' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Buffalo souvlaki' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5086,7 +5086,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Italian gyro' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Italian gyro' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Italian gyro' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Italian gyro' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5104,7 +5104,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Italian souvlaki' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Italian souvlaki' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Italian souvlaki' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Italian souvlaki' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5122,7 +5122,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Cordon bleu gyro' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cordon bleu gyro' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cordon bleu gyro' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Cordon bleu gyro' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5140,7 +5140,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Cordon bleu souvlaki' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cordon bleu souvlaki' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cordon bleu souvlaki' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Cordon bleu souvlaki' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5158,7 +5158,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Hummus gyro' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hummus gyro' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hummus gyro' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Hummus gyro' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5176,7 +5176,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Hummus souvlaki' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hummus souvlaki' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hummus souvlaki' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Hummus souvlaki' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5194,7 +5194,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Bbq gyro' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bbq gyro' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bbq gyro' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Bbq gyro' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5212,7 +5212,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Bbq souvlaki' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bbq souvlaki' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bbq souvlaki' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Bbq souvlaki' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5230,7 +5230,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Philly gyro' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Philly gyro' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Philly gyro' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Philly gyro' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5248,7 +5248,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Philly souvlaki' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Philly souvlaki' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Philly souvlaki' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Philly souvlaki' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5266,7 +5266,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Sicilian gyro' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Sicilian gyro' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Sicilian gyro' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Sicilian gyro' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5284,7 +5284,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Sicilian souvlaki' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Sicilian souvlaki' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Sicilian souvlaki' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Sicilian souvlaki' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5302,7 +5302,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Gyro burrito' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Gyro burrito' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Gyro burrito' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Gyro burrito' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5320,7 +5320,7 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('meat & bbq', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'meat & bbq' and d.name = 'Souvlaki burrito' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Souvlaki burrito' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Souvlaki burrito' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Souvlaki burrito' AND i.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('sweet', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5338,9 +5338,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Basil pesto chicken' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Basil pesto chicken' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Basil pesto chicken' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Basil pesto chicken' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Basil pesto chicken' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Basil pesto chicken' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5352,9 +5352,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Bbq steak on a wedge' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bbq steak on a wedge' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bbq steak on a wedge' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bbq steak on a wedge' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bbq steak on a wedge' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Bbq steak on a wedge' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5366,9 +5366,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Buffalo chicken' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Buffalo chicken' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Buffalo chicken' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Buffalo chicken' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Buffalo chicken' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Buffalo chicken' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5380,9 +5380,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Caesar wrap' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Caesar wrap' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Caesar wrap' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Caesar wrap' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Caesar wrap' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Caesar wrap' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5394,9 +5394,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Californian' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Californian' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Californian' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Californian' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Californian' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Californian' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5408,9 +5408,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Chicken bacon' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chicken bacon' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chicken bacon' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chicken bacon' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chicken bacon' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Chicken bacon' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5422,9 +5422,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Chipotle steak on a wrap' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chipotle steak on a wrap' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chipotle steak on a wrap' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chipotle steak on a wrap' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chipotle steak on a wrap' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Chipotle steak on a wrap' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5436,9 +5436,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Cluckin russian' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cluckin russian' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cluckin russian' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cluckin russian' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cluckin russian' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Cluckin russian' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5450,9 +5450,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Chipotle chicken' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chipotle chicken' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chipotle chicken' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chipotle chicken' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chipotle chicken' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Chipotle chicken' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5464,9 +5464,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Classic club' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Classic club' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Classic club' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Classic club' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Classic club' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Classic club' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5478,9 +5478,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Falafel and hummus' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Falafel and hummus' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Falafel and hummus' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Falafel and hummus' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Falafel and hummus' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Falafel and hummus' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5492,9 +5492,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Greek chicken flatbread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Greek chicken flatbread' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Greek chicken flatbread' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Greek chicken flatbread' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Greek chicken flatbread' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Greek chicken flatbread' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5506,9 +5506,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Greek falafel pita' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Greek falafel pita' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Greek falafel pita' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Greek falafel pita' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Greek falafel pita' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Greek falafel pita' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5520,9 +5520,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Greek feta wrap' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Greek feta wrap' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Greek feta wrap' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Greek feta wrap' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Greek feta wrap' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Greek feta wrap' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5534,9 +5534,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Grilled ham and swiss cheese' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Grilled ham and swiss cheese' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Grilled ham and swiss cheese' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Grilled ham and swiss cheese' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Grilled ham and swiss cheese' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Grilled ham and swiss cheese' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5548,9 +5548,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Niko s philly chicken' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Niko s philly chicken' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Niko s philly chicken' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Niko s philly chicken' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Niko s philly chicken' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Niko s philly chicken' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5562,9 +5562,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Olympian falafel' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Olympian falafel' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Olympian falafel' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Olympian falafel' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Olympian falafel' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Olympian falafel' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5576,9 +5576,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Mediterranean wrap' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Mediterranean wrap' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Mediterranean wrap' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Mediterranean wrap' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Mediterranean wrap' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Mediterranean wrap' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5590,9 +5590,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Philly cheesesteak on a wedge' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Philly cheesesteak on a wedge' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Philly cheesesteak on a wedge' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Philly cheesesteak on a wedge' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Philly cheesesteak on a wedge' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Philly cheesesteak on a wedge' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5604,9 +5604,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Spicy falafel pita' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Spicy falafel pita' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Spicy falafel pita' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Spicy falafel pita' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Spicy falafel pita' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Spicy falafel pita' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5618,9 +5618,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Swiss alps' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Swiss alps' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Swiss alps' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Swiss alps' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Swiss alps' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Swiss alps' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5632,9 +5632,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Turkey and cheese' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Turkey and cheese' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Turkey and cheese' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Turkey and cheese' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Turkey and cheese' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Turkey and cheese' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5646,9 +5646,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Turkey club' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Turkey club' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Turkey club' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Turkey club' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Turkey club' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Turkey club' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5660,9 +5660,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('sandwiches & bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'sandwiches & bread' and d.name = 'Veggie' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Veggie' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Veggie' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Veggie' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Veggie' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Veggie' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('chicken', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5754,9 +5754,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Hamburger' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hamburger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hamburger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hamburger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hamburger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Hamburger' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5768,9 +5768,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Cheeseburger' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cheeseburger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cheeseburger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cheeseburger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Cheeseburger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Cheeseburger' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5782,9 +5782,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Bacon burger' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bacon burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bacon burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bacon burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bacon burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Bacon burger' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5796,9 +5796,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Bacon cheeseburger' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bacon cheeseburger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bacon cheeseburger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bacon cheeseburger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bacon cheeseburger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Bacon cheeseburger' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5810,9 +5810,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Brazilian burger' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Brazilian burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Brazilian burger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Brazilian burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Brazilian burger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Brazilian burger' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5824,9 +5824,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Philly cheeseburger' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Philly cheeseburger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Philly cheeseburger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Philly cheeseburger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Philly cheeseburger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Philly cheeseburger' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5838,9 +5838,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Bbq bacon onion ring cheeseburger' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bbq bacon onion ring cheeseburger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bbq bacon onion ring cheeseburger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bbq bacon onion ring cheeseburger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bbq bacon onion ring cheeseburger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Bbq bacon onion ring cheeseburger' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5852,9 +5852,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Chipotle cheeseburger' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chipotle cheeseburger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chipotle cheeseburger' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chipotle cheeseburger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Chipotle cheeseburger' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Chipotle cheeseburger' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5866,9 +5866,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Hot dog' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hot dog' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hot dog' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hot dog' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Hot dog' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Hot dog' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -5880,9 +5880,9 @@ INSERT INTO tfm.dishes (name, description, cost, likes, dislikes, created_at, up
 INSERT INTO tfm.categories (name, created_at, updated_at) VALUES ('burgers & hot dog', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_categories (dish_id, category_id) SELECT d.id, c.id FROM tfm.categories c, tfm.dishes d WHERE c.name = 'burgers & hot dog' and d.name = 'Bacon cheese dog' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('meat', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bacon cheese dog' AND a.name = 'meat' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bacon cheese dog' AND a.name = 'meat' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.allergens (name, created_at, updated_at) VALUES ('gluten', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
-INSERT INTO tfm.dish_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bacon cheese dog' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_extra_allergens (dish_id, allergen_id) SELECT d.id, a.id FROM tfm.dishes d, tfm.allergens a WHERE d.name = 'Bacon cheese dog' AND a.name = 'gluten' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('bread', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Bacon cheese dog' AND i.name = 'bread' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('beef', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
@@ -6485,3 +6485,23 @@ INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('ingredient',
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Rice almond salad' AND i.name = 'ingredient' ON CONFLICT DO NOTHING;
 INSERT INTO tfm.ingredients (name, created_at, updated_at) VALUES ('2', current_timestamp, current_timestamp) ON CONFLICT DO NOTHING;
 INSERT INTO tfm.dish_ingredients (dish_id, ingredient_id) SELECT d.id, i.id FROM tfm.dishes d, tfm.ingredients i WHERE d.name = 'Rice almond salad' AND i.name = '2' ON CONFLICT DO NOTHING;
+
+-- allergens contained by the ingredients, with the names of the regulated allergens
+INSERT INTO tfm.ingredient_allergens (ingredient_id, allergen_id) SELECT i.id, a.id FROM tfm.ingredients i, tfm.allergens a
+WHERE (i.name, a.name) IN (('eggs', 'eggs'), ('bread', 'gluten'), ('flour', 'gluten'), ('pasta', 'gluten'), ('mustard', 'mustard'),
+	('cheese', 'milk'), ('cream', 'milk'), ('sour', 'milk'), ('fish', 'fish')) ON CONFLICT DO NOTHING;
+
+-- extra allergens are the ones the ingredients don't contain
+DELETE FROM tfm.dish_extra_allergens e USING tfm.dish_ingredients di, tfm.ingredient_allergens ia
+WHERE di.dish_id = e.dish_id AND ia.ingredient_id = di.ingredient_id AND ia.allergen_id = e.allergen_id;
+
+-- dish allergens and traces are calculated, as the application does when dishes or ingredients change
+INSERT INTO tfm.dish_allergens (dish_id, allergen_id)
+SELECT di.dish_id, ia.allergen_id FROM tfm.dish_ingredients di JOIN tfm.ingredient_allergens ia ON ia.ingredient_id = di.ingredient_id
+UNION
+SELECT dish_id, allergen_id FROM tfm.dish_extra_allergens
+ON CONFLICT DO NOTHING;
+INSERT INTO tfm.dish_traces (dish_id, allergen_id)
+SELECT DISTINCT di.dish_id, it.allergen_id FROM tfm.dish_ingredients di JOIN tfm.ingredient_traces it ON it.ingredient_id = di.ingredient_id
+WHERE NOT EXISTS (SELECT 1 FROM tfm.dish_allergens da WHERE da.dish_id = di.dish_id AND da.allergen_id = it.allergen_id)
+ON CONFLICT DO NOTHING;
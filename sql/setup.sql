@@ -6,4 +6,5 @@ DROP SCHEMA IF EXISTS tfm;
 CREATE SCHEMA tfm;
 ALTER SCHEMA tfm OWNER TO tfm;
 CREATE EXTENSION IF NOT EXISTS pg_trgm;
+CREATE EXTENSION IF NOT EXISTS btree_gist;
 